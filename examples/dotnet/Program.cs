@@ -1,21 +1,51 @@
 using System.Collections.Generic;
 using System.Linq;
 using Pulumi;
-using neon = Pulumi.neon;
+using Neon = Pulumi.Neon;
 
 return await Deployment.RunAsync(() => 
 {
-    var myRandomResource = new neon.Random("myRandomResource", new()
+    var project = new Neon.Project("project", new()
     {
-        Length = 24,
+        Name = "example",
+        RegionId = "aws-us-east-2",
+        PgVersion = 16,
+    });
+
+    var branch = new Neon.Branch("branch", new()
+    {
+        ProjectId = project.ProjectId,
+        Name = "dev",
+    });
+
+    var endpoint = new Neon.Endpoint("endpoint", new()
+    {
+        ProjectId = project.ProjectId,
+        BranchId = branch.BranchId,
+        Type = "read_write",
+    });
+
+    var owner = new Neon.Role("owner", new()
+    {
+        ProjectId = project.ProjectId,
+        BranchId = branch.BranchId,
+        Name = "app",
+    });
+
+    var database = new Neon.Database("database", new()
+    {
+        ProjectId = project.ProjectId,
+        BranchId = branch.BranchId,
+        Name = "app",
+        OwnerName = owner.Name,
     });
 
     return new Dictionary<string, object?>
     {
-        ["output"] = 
-        {
-            { "value", myRandomResource.Result },
-        },
+        ["projectId"] = project.ProjectId,
+        ["branchId"] = branch.BranchId,
+        ["host"] = endpoint.Host,
+        ["databaseName"] = database.Name,
     };
 });
 
@@ -7,15 +7,50 @@ import (
 
 func main() {
 	pulumi.Run(func(ctx *pulumi.Context) error {
-		myRandomResource, err := neon.NewRandom(ctx, "myRandomResource", &neon.RandomArgs{
-			Length: pulumi.Int(24),
+		project, err := neon.NewProject(ctx, "project", &neon.ProjectArgs{
+			Name:      pulumi.String("example"),
+			RegionId:  pulumi.String("aws-us-east-2"),
+			PgVersion: pulumi.Int(16),
 		})
 		if err != nil {
 			return err
 		}
-		ctx.Export("output", map[string]interface{}{
-			"value": myRandomResource.Result,
+		branch, err := neon.NewBranch(ctx, "branch", &neon.BranchArgs{
+			ProjectId: project.ProjectId,
+			Name:      pulumi.String("dev"),
 		})
+		if err != nil {
+			return err
+		}
+		endpoint, err := neon.NewEndpoint(ctx, "endpoint", &neon.EndpointArgs{
+			ProjectId: project.ProjectId,
+			BranchId:  branch.BranchId,
+			Type:      pulumi.String("read_write"),
+		})
+		if err != nil {
+			return err
+		}
+		owner, err := neon.NewRole(ctx, "owner", &neon.RoleArgs{
+			ProjectId: project.ProjectId,
+			BranchId:  branch.BranchId,
+			Name:      pulumi.String("app"),
+		})
+		if err != nil {
+			return err
+		}
+		database, err := neon.NewDatabase(ctx, "database", &neon.DatabaseArgs{
+			ProjectId: project.ProjectId,
+			BranchId:  branch.BranchId,
+			Name:      pulumi.String("app"),
+			OwnerName: owner.Name,
+		})
+		if err != nil {
+			return err
+		}
+		ctx.Export("projectId", project.ProjectId)
+		ctx.Export("branchId", branch.BranchId)
+		ctx.Export("host", endpoint.Host)
+		ctx.Export("databaseName", database.Name)
 		return nil
 	})
 }
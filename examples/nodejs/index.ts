@@ -1,31 +1,39 @@
 import * as pulumi from "@pulumi/pulumi";
 import * as neon from "@pulumi/neon";
 
-// Get the Neon API key from Pulumi config
-const config = new pulumi.Config();
-//const neonApiKey = config.requireSecret("neonApiKey");
+// The provider reads its API key from the neon:apiKey config value or, failing that, the
+// NEON_API_KEY environment variable.
+const project = new neon.Project("project", {
+    name: "example",
+    regionId: "aws-us-east-2",
+    pgVersion: 16,
+});
 
-// Configure the Neon provider with the API key
-const neonProvider = new neon.Provider("neonProvider", {
-    apiKey: "c1v9gfm4f2ug1hsacotgaix1ibvrbw57yd0f34msbdnja2zy25tl8qbv52q71w1f",
+const branch = new neon.Branch("branch", {
+    projectId: project.projectId,
+    name: "dev",
 });
 
-const project = new neon.Project("MyNeonProject", {
-    name: "MyNeonProject",
-    regionId: "aws-us-west-2",
-}, { provider: neonProvider });
+const endpoint = new neon.Endpoint("endpoint", {
+    projectId: project.projectId,
+    branchId: branch.branchId,
+    type: "read_write",
+});
 
-const branch = new neon.Branch("myBranch", {
-    projectId: project.id,
-    name: "main",
-}, { provider: neonProvider });
+const owner = new neon.Role("owner", {
+    projectId: project.projectId,
+    branchId: branch.branchId,
+    name: "app",
+});
 
-const database = new neon.Database("myDatabase", {
-    projectId: project.id,
-    branchId: branch.id,
-    name: "mydb",
-}, { provider: neonProvider });
+const database = new neon.Database("database", {
+    projectId: project.projectId,
+    branchId: branch.branchId,
+    name: "app",
+    ownerName: owner.name,
+});
 
-export const neonProjectId = project.id;
-export const neonBranchId = branch.id;
-export const neonDatabaseName = database.name;
\ No newline at end of file
+export const projectId = project.projectId;
+export const branchId = branch.branchId;
+export const host = endpoint.host;
+export const databaseName = database.name;
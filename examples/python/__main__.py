@@ -1,7 +1,27 @@
 import pulumi
 import pulumi_neon as neon
 
-my_random_resource = neon.Random("myRandomResource", length=24)
-pulumi.export("output", {
-    "value": my_random_resource.result,
-})
+project = neon.Project("project",
+    name="example",
+    region_id="aws-us-east-2",
+    pg_version=16)
+branch = neon.Branch("branch",
+    project_id=project.project_id,
+    name="dev")
+endpoint = neon.Endpoint("endpoint",
+    project_id=project.project_id,
+    branch_id=branch.branch_id,
+    type="read_write")
+owner = neon.Role("owner",
+    project_id=project.project_id,
+    branch_id=branch.branch_id,
+    name="app")
+database = neon.Database("database",
+    project_id=project.project_id,
+    branch_id=branch.branch_id,
+    name="app",
+    owner_name=owner.name)
+pulumi.export("projectId", project.project_id)
+pulumi.export("branchId", branch.branch_id)
+pulumi.export("host", endpoint.host)
+pulumi.export("databaseName", database.name)
@@ -1,259 +1,345 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"reflect"
+	"time"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 )
 
 type Branch struct{}
 
+func (b *Branch) Annotate(a infer.Annotator) {
+	a.Describe(&b, "A branch of a Neon project. Import it with an ID of the form "+branchIDFormat+".")
+}
+
+// BranchArgs configures a Neon branch. The parent settings choose where the branch is cut
+// from and cannot change once it exists. When they are left unset Neon branches from the
+// head of the project's default branch, and the state records what it chose.
 type BranchArgs struct {
 	ProjectId string `pulumi:"projectId"`
 	Name      string `pulumi:"name"`
+	// ParentId is the branch to branch from.
+	ParentId *string `pulumi:"parentId,optional"`
+	// ParentLsn branches from the parent as of a Log Sequence Number.
+	ParentLsn *string `pulumi:"parentLsn,optional"`
+	// ParentTimestamp branches from the parent as of an RFC 3339 point in time.
+	ParentTimestamp *string `pulumi:"parentTimestamp,optional"`
+	// ExpiresAt is the RFC 3339 time at which Neon deletes the branch. Ttl sets it
+	// relative to the branch's creation instead, as a duration such as 72h. Only one of
+	// them may be set, and removing both removes the expiration.
+	ExpiresAt *string `pulumi:"expiresAt,optional"`
+	Ttl       *string `pulumi:"ttl,optional"`
+	// Endpoints are compute endpoints to create together with the branch. No endpoints
+	// are created when it is empty. Changing it replaces the branch.
+	Endpoints []BranchEndpoint `pulumi:"endpoints,optional"`
+}
+
+func (args *BranchArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.ProjectId, "The ID of the project the branch belongs to. Changing it replaces the branch.")
+	a.Describe(&args.Name, "The name of the branch.")
+	a.Describe(&args.ParentId, "The ID of the branch to branch from. Defaults to the project's default branch. Changing it replaces the branch.")
+	a.Describe(&args.ParentLsn, "Branch from the parent as of this Log Sequence Number. Changing it replaces the branch.")
+	a.Describe(&args.ParentTimestamp, "Branch from the parent as of this RFC 3339 point in time. Changing it replaces the branch.")
+	a.Describe(&args.ExpiresAt, "The RFC 3339 time at which Neon deletes the branch. Only one of expiresAt and ttl may be set.")
+	a.Describe(&args.Ttl, "How long after its creation Neon deletes the branch, as a duration such as 72h. Only one of expiresAt and ttl may be set.")
+	a.Describe(&args.Endpoints, "Compute endpoints to create together with the branch. Changing them replaces the branch.")
+}
+
+// BranchEndpoint describes a compute endpoint created along with its branch.
+type BranchEndpoint struct {
+	// Type is read_write or read_only.
+	Type                  string   `pulumi:"type"`
+	AutoscalingLimitMinCu *float64 `pulumi:"autoscalingLimitMinCu,optional"`
+	AutoscalingLimitMaxCu *float64 `pulumi:"autoscalingLimitMaxCu,optional"`
+	SuspendTimeoutSeconds *int     `pulumi:"suspendTimeoutSeconds,optional"`
+	Provisioner           *string  `pulumi:"provisioner,optional"`
+}
+
+func (e *BranchEndpoint) Annotate(a infer.Annotator) {
+	a.Describe(&e, "A compute endpoint created along with its branch.")
+	a.Describe(&e.Type, "The endpoint type, read_write or read_only.")
+	a.Describe(&e.AutoscalingLimitMinCu, "The minimum compute size, in compute units.")
+	a.Describe(&e.AutoscalingLimitMaxCu, "The maximum compute size, in compute units.")
+	a.Describe(&e.SuspendTimeoutSeconds, "How long, in seconds, an idle endpoint keeps running before it is suspended.")
+	a.Describe(&e.Provisioner, "The compute provisioner, k8s-pod or k8s-neonvm.")
 }
 
 type BranchState struct {
 	BranchArgs
-	Id        string `pulumi:"id"`
-	CreatedAt string `pulumi:"createdAt"`
+	BranchId string `pulumi:"branchId"`
+	// CreatedEndpoints are the endpoints created from Endpoints, in the same order. They
+	// are deleted along with the branch.
+	CreatedEndpoints []CreatedEndpoint `pulumi:"createdEndpoints,optional"`
+	CreatedAt        string            `pulumi:"createdAt"`
 }
 
-func (b Branch) Create(ctx context.Context, name string, input BranchArgs, preview bool) (string, BranchState, error) {
-	if preview {
-		return name, BranchState{BranchArgs: input}, nil
-	}
+func (s *BranchState) Annotate(a infer.Annotator) {
+	a.Describe(&s.BranchId, "The ID Neon assigned to the branch.")
+	a.Describe(&s.CreatedEndpoints, "The endpoints created from endpoints, in the same order. They are deleted along with the branch.")
+	a.Describe(&s.CreatedAt, "When the branch was created, in RFC 3339 format.")
+}
 
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return "", BranchState{}, fmt.Errorf("missing configuration")
-	}
+type CreatedEndpoint struct {
+	EndpointId string `pulumi:"endpointId"`
+	Host       string `pulumi:"host"`
+	Type       string `pulumi:"type"`
+}
 
-	branchData := struct {
-		Name string `json:"name"`
-	}{
-		Name: input.Name,
-	}
+func (e *CreatedEndpoint) Annotate(a infer.Annotator) {
+	a.Describe(&e, "An endpoint created along with its branch.")
+	a.Describe(&e.EndpointId, "The ID Neon assigned to the endpoint.")
+	a.Describe(&e.Host, "The hostname of the endpoint.")
+	a.Describe(&e.Type, "The endpoint type, read_write or read_only.")
+}
 
-	jsonData, err := json.Marshal(map[string]interface{}{
-		"branch": branchData,
-		"endpoints": []map[string]string{
-			{"type": "read_only"},
-		},
-	})
-	if err != nil {
-		return "", BranchState{}, fmt.Errorf("failed to marshal branch data: %v", err)
+// WireDependencies keeps the branch's ID known when previewing an update, which at most
+// renames it or changes when it expires.
+func (b Branch) WireDependencies(f infer.FieldSelector, args *BranchArgs, state *BranchState) {
+	f.OutputField(&state.Name).DependsOn(f.InputField(&args.Name))
+	f.OutputField(&state.ExpiresAt).DependsOn(f.InputField(&args.ExpiresAt), f.InputField(&args.Ttl))
+}
+
+// expiration returns when the branch should expire if it is created at createdAt, or nil
+// if it should not.
+func (args BranchArgs) expiration(createdAt time.Time) (*string, error) {
+	if args.Ttl == nil {
+		return args.ExpiresAt, nil
+	}
+	ttl, err := time.ParseDuration(*args.Ttl)
+	if err != nil || ttl <= 0 {
+		return nil, fmt.Errorf("invalid ttl %q: expected a positive duration such as 72h", *args.Ttl)
 	}
+	expiresAt := createdAt.Add(ttl).UTC().Format(time.RFC3339)
+	return &expiresAt, nil
+}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/projects/%s/branches", baseURL, input.ProjectId), bytes.NewBuffer(jsonData))
-	if err != nil {
-		return "", BranchState{}, fmt.Errorf("failed to create request: %v", err)
+// expired reports whether the branch's expiration has passed. Neon deletes expired
+// branches shortly after they expire, so they are treated as already gone.
+func (s BranchState) expired() bool {
+	if s.ExpiresAt == nil {
+		return false
 	}
+	expiresAt, err := time.Parse(time.RFC3339, *s.ExpiresAt)
+	return err == nil && !time.Now().Before(expiresAt)
+}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
+// Check validates the branch's inputs before anything is sent to Neon.
+func (b Branch) Check(ctx context.Context, name string, oldInputs, newInputs resource.PropertyMap) (BranchArgs, []p.CheckFailure, error) {
+	args, failures, err := infer.DefaultCheck[BranchArgs](ctx, newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", BranchState{}, fmt.Errorf("failed to send request: %v", err)
+	c := newChecker(newInputs)
+	c.check("parentLsn", validLSN)
+	c.check("parentTimestamp", validTimestamp)
+	c.check("expiresAt", validTimestamp)
+	c.check("ttl", validDuration)
+	if _, ok := stringAt(newInputs, "parentLsn"); ok {
+		if _, ok := stringAt(newInputs, "parentTimestamp"); ok {
+			c.fail("parentTimestamp", "parentLsn and parentTimestamp cannot both be set")
+		}
 	}
-	defer resp.Body.Close()
+	if _, ok := stringAt(newInputs, "expiresAt"); ok {
+		if _, ok := stringAt(newInputs, "ttl"); ok {
+			c.fail("ttl", "expiresAt and ttl cannot both be set")
+		}
+	}
+	c.checkEach("endpoints", func(endpoint resource.PropertyMap, path string) {
+		c.checkAt(endpoint, "type", path+".type", validEndpointType)
+		c.checkAt(endpoint, "provisioner", path+".provisioner", validProvisioner)
+		c.checkComputeLimits(endpoint, path+".")
+	})
+	return args, c.failures, nil
+}
 
-	body, err := io.ReadAll(resp.Body)
+func (b Branch) Create(ctx context.Context, name string, input BranchArgs, preview bool) (string, BranchState, error) {
+	client, err := getClient(ctx)
 	if err != nil {
-		return "", BranchState{}, fmt.Errorf("failed to read response body: %v", err)
+		return "", BranchState{}, err
 	}
-
-	if resp.StatusCode != http.StatusCreated {
-		return "", BranchState{}, fmt.Errorf("failed to create branch: %s", string(body))
+	if preview {
+		for _, endpoint := range input.Endpoints {
+			if err := client.checkComputeLimit(ctx, endpoint.AutoscalingLimitMaxCu); err != nil {
+				return "", BranchState{}, err
+			}
+		}
+		state := BranchState{BranchArgs: input}
+		state.ExpiresAt, _ = input.expiration(time.Now())
+		return "", state, nil
 	}
 
-	var result struct {
-		Branch struct {
-			Id        string `json:"id"`
-			Name      string `json:"name"`
-			ProjectId string `json:"project_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"branch"`
+	branch, err := client.CreateBranch(ctx, input)
+	if IsOperationFailed(err) {
+		return resourceID(branch.ProjectId, branch.BranchId), *branch, initFailed(err)
 	}
-
-	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return "", BranchState{}, fmt.Errorf("failed to unmarshal response: %v", err)
+		return "", BranchState{}, fmt.Errorf("failed to create branch: %w", err)
 	}
 
-	return name, BranchState{
-		BranchArgs: BranchArgs{
-			ProjectId: result.Branch.ProjectId,
-			Name:      result.Branch.Name,
-		},
-		Id:        result.Branch.Id,
-		CreatedAt: result.Branch.CreatedAt,
-	}, nil
+	return resourceID(branch.ProjectId, branch.BranchId), *branch, nil
 }
 
 func (b Branch) Read(ctx context.Context, id string, inputs BranchArgs, state BranchState) (string, BranchArgs, BranchState, error) {
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return "", BranchArgs{}, BranchState{}, fmt.Errorf("missing configuration")
-	}
-
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/projects/%s/branches/%s", baseURL, state.ProjectId, state.Id), nil)
+	client, err := getClient(ctx)
 	if err != nil {
-		return "", BranchArgs{}, BranchState{}, fmt.Errorf("failed to create request: %v", err)
+		return "", BranchArgs{}, BranchState{}, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", BranchArgs{}, BranchState{}, fmt.Errorf("failed to send request: %v", err)
+	projectId, branchId := state.ProjectId, state.BranchId
+	if projectId == "" || branchId == "" {
+		parts, err := parseResourceID(id, branchIDFormat)
+		if err != nil {
+			return "", BranchArgs{}, BranchState{}, err
+		}
+		projectId, branchId = parts[0], parts[1]
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	branch, err := client.GetBranch(ctx, projectId, branchId)
 	if err != nil {
-		return "", BranchArgs{}, BranchState{}, fmt.Errorf("failed to read response body: %v", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		if IsNotFoundError(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))) {
+		if IsNotFound(err) {
 			return "", BranchArgs{}, BranchState{}, nil
 		}
-		return "", BranchArgs{}, BranchState{}, fmt.Errorf("failed to read branch: %s", string(body))
+		return "", BranchArgs{}, BranchState{}, fmt.Errorf("failed to read branch: %w", err)
 	}
-
-	var result struct {
-		Branch struct {
-			Id        string `json:"id"`
-			Name      string `json:"name"`
-			ProjectId string `json:"project_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"branch"`
+	if branch.expired() {
+		return "", BranchArgs{}, BranchState{}, nil
 	}
 
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return "", BranchArgs{}, BranchState{}, fmt.Errorf("failed to unmarshal response: %v", err)
+	// Inline endpoints and the ttl only exist in the create request, so they are carried
+	// over. The endpoints created from them are refreshed, dropping any deleted outside
+	// of Pulumi.
+	branch.Endpoints = state.Endpoints
+	branch.Ttl = state.Ttl
+	if len(state.CreatedEndpoints) > 0 {
+		endpoints, err := client.ListBranchEndpoints(ctx, projectId, branchId)
+		if err != nil {
+			return "", BranchArgs{}, BranchState{}, fmt.Errorf("failed to read branch endpoints: %w", err)
+		}
+		branch.CreatedEndpoints = refreshCreatedEndpoints(state.CreatedEndpoints, endpoints)
 	}
 
-	return id, BranchArgs{
-		ProjectId: result.Branch.ProjectId,
-		Name:      result.Branch.Name,
-	}, BranchState{
-		BranchArgs: BranchArgs{
-			ProjectId: result.Branch.ProjectId,
-			Name:      result.Branch.Name,
-		},
-		Id:        result.Branch.Id,
-		CreatedAt: result.Branch.CreatedAt,
-	}, nil
+	args := branch.BranchArgs
+	if args.Ttl != nil {
+		args.ExpiresAt = nil
+	}
+	return resourceID(branch.ProjectId, branch.BranchId), args, *branch, nil
 }
 
-func (b Branch) Update(ctx context.Context, id string, olds BranchState, news BranchArgs, preview bool) (BranchState, error) {
-	if preview {
-		return BranchState{
-			BranchArgs: news,
-			Id:         olds.Id,
-			CreatedAt:  olds.CreatedAt,
-		}, nil
-	}
+// Diff compares the program's inputs with the last known state of the branch. Only the
+// name and expiration can be changed in place; moving a branch to another project or
+// parent, or changing its inline endpoints, replaces it.
+func (b Branch) Diff(ctx context.Context, id string, olds BranchState, news BranchArgs) (p.DiffResponse, error) {
+	diff := diffBuilder{}
+	diff.update("name", olds.Name != news.Name)
+	diff.update("ttl", !reflect.DeepEqual(olds.Ttl, news.Ttl))
+	diff.update("expiresAt", expirationChanged(olds, news))
+	diff.replace("projectId", olds.ProjectId != news.ProjectId)
+	diff.replace("parentId", optionalChanged(olds.ParentId, news.ParentId))
+	diff.replace("parentLsn", optionalChanged(olds.ParentLsn, news.ParentLsn))
+	diff.replace("parentTimestamp", timestampChanged(olds.ParentTimestamp, news.ParentTimestamp))
+	diff.replace("endpoints", (len(olds.Endpoints) > 0 || len(news.Endpoints) > 0) && !reflect.DeepEqual(olds.Endpoints, news.Endpoints))
+
+	// Branch names are unique within a project, so a replacement that keeps the name has
+	// to delete the old branch first.
+	resp := diff.response()
+	resp.DeleteBeforeReplace = diff.replaces() && olds.ProjectId == news.ProjectId && olds.Name == news.Name
+	return resp, nil
+}
 
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return BranchState{}, fmt.Errorf("missing configuration")
+// expirationChanged reports whether expiresAt changed. An expiration set through ttl is
+// compared by the ttl diff instead, and removing both clears the expiration.
+func expirationChanged(olds BranchState, news BranchArgs) bool {
+	switch {
+	case news.Ttl != nil:
+		return false
+	case news.ExpiresAt == nil:
+		return olds.Ttl == nil && olds.ExpiresAt != nil
+	default:
+		return olds.Ttl != nil || timestampChanged(olds.ExpiresAt, news.ExpiresAt)
 	}
+}
 
-	branchData := struct {
-		Name string `json:"name"`
-	}{
-		Name: news.Name,
+// refreshCreatedEndpoints updates created with the current endpoints of the branch.
+func refreshCreatedEndpoints(created []CreatedEndpoint, endpoints []apiEndpoint) []CreatedEndpoint {
+	var refreshed []CreatedEndpoint
+	for _, c := range created {
+		for _, endpoint := range endpoints {
+			if endpoint.Id == c.EndpointId {
+				refreshed = append(refreshed, CreatedEndpoint{EndpointId: endpoint.Id, Host: endpoint.Host, Type: endpoint.Type})
+				break
+			}
+		}
 	}
+	return refreshed
+}
 
-	jsonData, err := json.Marshal(map[string]interface{}{"branch": branchData})
+func (b Branch) Update(ctx context.Context, id string, olds BranchState, news BranchArgs, preview bool) (BranchState, error) {
+	createdAt, err := time.Parse(time.RFC3339, olds.CreatedAt)
 	if err != nil {
-		return BranchState{}, fmt.Errorf("failed to marshal branch data: %v", err)
+		createdAt = time.Now()
 	}
-
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/projects/%s/branches/%s", baseURL, news.ProjectId, olds.Id), bytes.NewBuffer(jsonData))
+	expiresAt, err := news.expiration(createdAt)
 	if err != nil {
-		return BranchState{}, fmt.Errorf("failed to create request: %v", err)
+		return BranchState{}, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return BranchState{}, fmt.Errorf("failed to send request: %v", err)
+	if preview {
+		state := BranchState{
+			BranchArgs:       news,
+			BranchId:         olds.BranchId,
+			CreatedEndpoints: olds.CreatedEndpoints,
+			CreatedAt:        olds.CreatedAt,
+		}
+		state.ExpiresAt = expiresAt
+		return state, nil
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	client, err := getClient(ctx)
 	if err != nil {
-		return BranchState{}, fmt.Errorf("failed to read response body: %v", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return BranchState{}, fmt.Errorf("failed to update branch: %s", string(body))
-	}
-
-	var result struct {
-		Branch struct {
-			Id        string `json:"id"`
-			Name      string `json:"name"`
-			ProjectId string `json:"project_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"branch"`
+		return BranchState{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	branch, err := client.UpdateBranch(ctx, news.ProjectId, olds.BranchId, news.Name, expiresAt)
 	if err != nil {
-		return BranchState{}, fmt.Errorf("failed to unmarshal response: %v", err)
+		return BranchState{}, fmt.Errorf("failed to update branch: %w", err)
 	}
 
-	return BranchState{
-		BranchArgs: BranchArgs{
-			ProjectId: result.Branch.ProjectId,
-			Name:      result.Branch.Name,
-		},
-		Id:        result.Branch.Id,
-		CreatedAt: result.Branch.CreatedAt,
-	}, nil
+	branch.Endpoints = news.Endpoints
+	branch.Ttl = news.Ttl
+	branch.CreatedEndpoints = olds.CreatedEndpoints
+	return *branch, nil
 }
 
 func (b Branch) Delete(ctx context.Context, id string, state BranchState) error {
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return fmt.Errorf("missing configuration")
-	}
-
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/projects/%s/branches/%s", baseURL, state.ProjectId, state.Id), nil)
+	client, err := getClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %v", err)
+		return err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to send request: %v", err)
+	// Neon may already be deleting an expired branch, in which case it answers 422.
+	for _, endpoint := range state.CreatedEndpoints {
+		err := client.DeleteEndpoint(ctx, state.ProjectId, endpoint.EndpointId)
+		if err != nil && !IsNotFound(err) && !state.beingDeleted(err) {
+			return fmt.Errorf("failed to delete endpoint %s of branch: %w", endpoint.EndpointId, err)
+		}
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete branch: %s", string(body))
+	err = client.DeleteBranch(ctx, state.ProjectId, state.BranchId)
+	if err != nil && !IsNotFound(err) && !state.beingDeleted(err) {
+		return fmt.Errorf("failed to delete branch: %w", err)
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// beingDeleted reports whether err means Neon is already deleting the expired branch.
+func (s BranchState) beingDeleted(err error) bool {
+	return s.expired() && hasStatus(err, http.StatusUnprocessableEntity)
+}
@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	pschema "github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	pprovider "github.com/pulumi/pulumi/pkg/v3/resource/provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	rpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Branch methods act on an existing branch without changing its inputs. pulumi-go-provider
+// does not serve resource methods yet, so each method is an internal function taking the
+// branch's ID as __self__. The schema presents the functions as methods of Branch, and
+// methodServer forwards calls to them.
+
+type BranchResetToParent struct{}
+
+func (f *BranchResetToParent) Annotate(a infer.Annotator) {
+	a.Describe(&f, "Reset the branch to the latest state of its parent, discarding its own changes.")
+}
+
+type BranchResetToParentArgs struct {
+	Self string `pulumi:"__self__"`
+}
+
+type BranchRestore struct{}
+
+func (f *BranchRestore) Annotate(a infer.Annotator) {
+	a.Describe(&f, "Restore the branch's data from a source branch, as of its head, an LSN or a point in time.")
+}
+
+type BranchRestoreArgs struct {
+	Self           string `pulumi:"__self__"`
+	SourceBranchId string `pulumi:"sourceBranchId"`
+	// Lsn and Timestamp restore the source as of a Log Sequence Number or an RFC 3339
+	// point in time. Only one of them may be set; without either the source's head is
+	// used.
+	Lsn       *string `pulumi:"lsn,optional"`
+	Timestamp *string `pulumi:"timestamp,optional"`
+	// PreserveUnderName saves the branch's data before the restore as a new branch with
+	// this name.
+	PreserveUnderName *string `pulumi:"preserveUnderName,optional"`
+}
+
+func (args *BranchRestoreArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.SourceBranchId, "The ID of the branch to restore from.")
+	a.Describe(&args.Lsn, "Restore the source as of this Log Sequence Number. Only one of lsn and timestamp may be set.")
+	a.Describe(&args.Timestamp, "Restore the source as of this RFC 3339 point in time. Only one of lsn and timestamp may be set.")
+	a.Describe(&args.PreserveUnderName, "Save the branch's data from before the restore as a new branch with this name.")
+}
+
+// BranchMethodResult describes the branch after a method has changed it.
+type BranchMethodResult struct {
+	BranchId        string  `pulumi:"branchId"`
+	Name            string  `pulumi:"name"`
+	ParentId        *string `pulumi:"parentId,optional"`
+	ParentLsn       *string `pulumi:"parentLsn,optional"`
+	ParentTimestamp *string `pulumi:"parentTimestamp,optional"`
+}
+
+func (r *BranchMethodResult) Annotate(a infer.Annotator) {
+	a.Describe(&r, "The branch after a method has changed it.")
+	a.Describe(&r.BranchId, "The ID of the branch.")
+	a.Describe(&r.Name, "The name of the branch.")
+	a.Describe(&r.ParentId, "The ID of the branch's parent.")
+	a.Describe(&r.ParentLsn, "The Log Sequence Number of the parent the branch now starts from.")
+	a.Describe(&r.ParentTimestamp, "The point in time of the parent the branch now starts from.")
+}
+
+func (s BranchState) methodResult() BranchMethodResult {
+	return BranchMethodResult{
+		BranchId:        s.BranchId,
+		Name:            s.Name,
+		ParentId:        s.ParentId,
+		ParentLsn:       s.ParentLsn,
+		ParentTimestamp: s.ParentTimestamp,
+	}
+}
+
+func (BranchResetToParent) Call(ctx context.Context, args BranchResetToParentArgs) (BranchMethodResult, error) {
+	parts, err := parseResourceID(args.Self, branchIDFormat)
+	if err != nil {
+		return BranchMethodResult{}, err
+	}
+	projectId, branchId := parts[0], parts[1]
+	client, err := getClient(ctx)
+	if err != nil {
+		return BranchMethodResult{}, err
+	}
+
+	branch, err := client.GetBranch(ctx, projectId, branchId)
+	if err != nil {
+		return BranchMethodResult{}, fmt.Errorf("failed to read branch: %w", err)
+	}
+	if branch.ParentId == nil {
+		return BranchMethodResult{}, fmt.Errorf("branch %s has no parent to reset to", branchId)
+	}
+	branch, err = client.RestoreBranch(ctx, projectId, branchId, *branch.ParentId, nil, nil, nil)
+	if err != nil {
+		return BranchMethodResult{}, fmt.Errorf("failed to reset branch to its parent: %w", err)
+	}
+	return branch.methodResult(), nil
+}
+
+func (BranchRestore) Call(ctx context.Context, args BranchRestoreArgs) (BranchMethodResult, error) {
+	parts, err := parseResourceID(args.Self, branchIDFormat)
+	if err != nil {
+		return BranchMethodResult{}, err
+	}
+	projectId, branchId := parts[0], parts[1]
+	switch {
+	case args.Lsn != nil && args.Timestamp != nil:
+		return BranchMethodResult{}, fmt.Errorf("lsn and timestamp cannot both be set")
+	case args.Lsn != nil && validLSN(*args.Lsn) != "":
+		return BranchMethodResult{}, fmt.Errorf("lsn: %s", validLSN(*args.Lsn))
+	case args.Timestamp != nil && validTimestamp(*args.Timestamp) != "":
+		return BranchMethodResult{}, fmt.Errorf("timestamp: %s", validTimestamp(*args.Timestamp))
+	}
+	client, err := getClient(ctx)
+	if err != nil {
+		return BranchMethodResult{}, err
+	}
+
+	branch, err := client.RestoreBranch(ctx, projectId, branchId, args.SourceBranchId, args.Lsn, args.Timestamp, args.PreserveUnderName)
+	if err != nil {
+		return BranchMethodResult{}, fmt.Errorf("failed to restore branch: %w", err)
+	}
+	return branch.methodResult(), nil
+}
+
+// branchMethods maps the name of each Branch method to the function that implements it.
+var branchMethods = map[string]tokens.Type{
+	"resetToParent": "neon:index:branchResetToParent",
+	"restore":       "neon:index:branchRestore",
+}
+
+const branchToken = "neon:index:Branch"
+
+func branchMethodToken(name string) string {
+	return branchToken + "/" + name
+}
+
+// withBranchMethods rewrites the schema so that the functions implementing Branch methods
+// appear as methods of Branch instead.
+func withBranchMethods(prov p.Provider) p.Provider {
+	getSchema := prov.GetSchema
+	prov.GetSchema = func(ctx context.Context, req p.GetSchemaRequest) (p.GetSchemaResponse, error) {
+		resp, err := getSchema(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		var spec pschema.PackageSpec
+		if err := json.Unmarshal([]byte(resp.Schema), &spec); err != nil {
+			return resp, err
+		}
+
+		branch := spec.Resources[branchToken]
+		branch.Methods = map[string]string{}
+		for name, fn := range branchMethods {
+			method, ok := spec.Functions[string(fn)]
+			if !ok {
+				return resp, fmt.Errorf("missing function %s for method %s", fn, name)
+			}
+			delete(spec.Functions, string(fn))
+			method.Inputs.Properties["__self__"] = pschema.PropertySpec{
+				TypeSpec:    pschema.TypeSpec{Ref: "#/resources/" + branchToken},
+				Description: "The branch the method is called on.",
+			}
+			spec.Functions[branchMethodToken(name)] = method
+			branch.Methods[name] = branchMethodToken(name)
+		}
+		spec.Resources[branchToken] = branch
+
+		schema, err := json.Marshal(spec)
+		if err != nil {
+			return resp, err
+		}
+		resp.Schema = string(schema)
+		return resp, nil
+	}
+	return prov
+}
+
+// methodServer serves Branch methods by invoking the functions that implement them with
+// the ID of the branch they are called on.
+type methodServer struct {
+	rpc.ResourceProviderServer
+}
+
+func (s methodServer) Call(ctx context.Context, req *rpc.CallRequest) (*rpc.CallResponse, error) {
+	name, ok := strings.CutPrefix(req.GetTok(), branchToken+"/")
+	fn, known := branchMethods[name]
+	if !ok || !known {
+		return s.ResourceProviderServer.Call(ctx, req)
+	}
+
+	args, err := plugin.UnmarshalProperties(req.GetArgs(), plugin.MarshalOptions{
+		KeepUnknowns:  true,
+		KeepSecrets:   true,
+		KeepResources: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	self := args["__self__"]
+	if !self.IsResourceReference() {
+		return nil, fmt.Errorf("%s must be called on a Branch", req.GetTok())
+	}
+	// A preview must not change the branch, and a branch that is still to be created has
+	// nothing to act on, so the results are left unknown.
+	id := self.ResourceReferenceValue().ID
+	if req.GetDryRun() || !id.IsString() {
+		unknowns, err := unknownMethodResult()
+		if err != nil {
+			return nil, err
+		}
+		return &rpc.CallResponse{Return: unknowns}, nil
+	}
+	args["__self__"] = id
+
+	invokeArgs, err := plugin.MarshalProperties(args, plugin.MarshalOptions{KeepSecrets: true})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.Invoke(ctx, &rpc.InvokeRequest{Tok: string(fn), Args: invokeArgs})
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.CallResponse{Return: resp.GetReturn(), Failures: resp.GetFailures()}, nil
+}
+
+// unknownMethodResult returns every output of BranchMethodResult as an unknown value, so
+// that programs reading them during a preview see unknowns rather than missing outputs.
+func unknownMethodResult() (*structpb.Struct, error) {
+	outputs := resource.PropertyMap{}
+	fields := reflect.TypeOf(BranchMethodResult{})
+	for i := 0; i < fields.NumField(); i++ {
+		name, _, _ := strings.Cut(fields.Field(i).Tag.Get("pulumi"), ",")
+		outputs[resource.PropertyKey(name)] = resource.MakeComputed(resource.NewStringProperty(""))
+	}
+	return plugin.MarshalProperties(outputs, plugin.MarshalOptions{KeepUnknowns: true})
+}
+
+// Server creates the provider's gRPC server, including its resource methods.
+func Server(host *pprovider.HostClient) (rpc.ResourceProviderServer, error) {
+	server, err := p.RawServer(Name, Version, Provider())(host)
+	if err != nil {
+		return nil, err
+	}
+	return methodServer{server}, nil
+}
+
+// Serve runs the provider against Pulumi's Provider protocol.
+func Serve() error {
+	return pprovider.Main(Name, Server)
+}
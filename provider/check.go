@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+var (
+	regionIDPattern = regexp.MustCompile(`^(aws|azure|gcp)-[a-z0-9]+(-[a-z0-9]+)*$`)
+	orgIDPattern    = regexp.MustCompile(`^org-[a-z0-9]+(-[a-z0-9]+)*$`)
+	lsnPattern      = regexp.MustCompile(`^[0-9A-Fa-f]{1,8}/[0-9A-Fa-f]{1,8}$`)
+)
+
+// maxIdentifierLength is the longest name Postgres accepts for a role or database, in bytes.
+const maxIdentifierLength = 63
+
+// checker collects validation failures for a resource's inputs. Inputs whose values are
+// not known yet, as during a preview, are skipped.
+type checker struct {
+	inputs   resource.PropertyMap
+	failures []p.CheckFailure
+}
+
+func newChecker(inputs resource.PropertyMap) *checker {
+	return &checker{inputs: inputs}
+}
+
+func (c *checker) fail(property, reason string, args ...interface{}) {
+	c.failures = append(c.failures, p.CheckFailure{
+		Property: property,
+		Reason:   fmt.Sprintf(reason, args...),
+	})
+}
+
+// stringAt returns the string at key in inputs, if it is known.
+func stringAt(inputs resource.PropertyMap, key string) (string, bool) {
+	v, ok := inputs[resource.PropertyKey(key)]
+	if ok && v.IsSecret() {
+		v = v.SecretValue().Element
+	}
+	if !ok || !v.IsString() {
+		return "", false
+	}
+	return v.StringValue(), true
+}
+
+// numberAt returns the number at key in inputs, if it is known.
+func numberAt(inputs resource.PropertyMap, key string) (float64, bool) {
+	v, ok := inputs[resource.PropertyKey(key)]
+	if !ok || !v.IsNumber() {
+		return 0, false
+	}
+	return v.NumberValue(), true
+}
+
+// check validates the string at key with validate, which returns a reason on failure.
+func (c *checker) check(key string, validate func(string) string) {
+	c.checkAt(c.inputs, key, key, validate)
+}
+
+func (c *checker) checkAt(inputs resource.PropertyMap, key, path string, validate func(string) string) {
+	if v, ok := stringAt(inputs, key); ok {
+		if reason := validate(v); reason != "" {
+			c.fail(path, "%s", reason)
+		}
+	}
+}
+
+// checkEach validates the objects of the list at key with validate.
+func (c *checker) checkEach(key string, validate func(item resource.PropertyMap, path string)) {
+	v, ok := c.inputs[resource.PropertyKey(key)]
+	if !ok || !v.IsArray() {
+		return
+	}
+	for i, item := range v.ArrayValue() {
+		if item.IsObject() {
+			validate(item.ObjectValue(), fmt.Sprintf("%s[%d]", key, i))
+		}
+	}
+}
+
+// checkObject validates the object at key with validate.
+func (c *checker) checkObject(key string, validate func(object resource.PropertyMap, path string)) {
+	if v, ok := c.inputs[resource.PropertyKey(key)]; ok && v.IsObject() {
+		validate(v.ObjectValue(), key)
+	}
+}
+
+// checkComputeLimits requires the autoscaling minimum to be no larger than the maximum.
+func (c *checker) checkComputeLimits(inputs resource.PropertyMap, prefix string) {
+	min, minOk := numberAt(inputs, "autoscalingLimitMinCu")
+	max, maxOk := numberAt(inputs, "autoscalingLimitMaxCu")
+	if minOk && maxOk && min > max {
+		c.fail(prefix+"autoscalingLimitMinCu", "autoscalingLimitMinCu (%g) must not exceed autoscalingLimitMaxCu (%g)", min, max)
+	}
+}
+
+func oneOf(values ...string) func(string) string {
+	return func(v string) string {
+		for _, allowed := range values {
+			if v == allowed {
+				return ""
+			}
+		}
+		return fmt.Sprintf("%q is not one of %s", v, strings.Join(values, ", "))
+	}
+}
+
+var (
+	validEndpointType = oneOf("read_write", "read_only")
+	validProvisioner  = oneOf("k8s-pod", "k8s-neonvm")
+	validPoolerMode   = oneOf("transaction")
+)
+
+func validRegionID(v string) string {
+	if !regionIDPattern.MatchString(v) {
+		return fmt.Sprintf("%q is not a Neon region ID such as aws-us-east-2", v)
+	}
+	return ""
+}
+
+func validOrgID(v string) string {
+	if !orgIDPattern.MatchString(v) {
+		return fmt.Sprintf("%q is not a Neon organization ID such as org-cool-breeze-12345678", v)
+	}
+	return ""
+}
+
+func validLSN(v string) string {
+	if !lsnPattern.MatchString(v) {
+		return fmt.Sprintf("%q is not a Log Sequence Number such as 0/1F4B2C8", v)
+	}
+	return ""
+}
+
+func validTimestamp(v string) string {
+	if _, err := time.Parse(time.RFC3339, v); err != nil {
+		return fmt.Sprintf("%q is not an RFC 3339 timestamp", v)
+	}
+	return ""
+}
+
+func validDuration(v string) string {
+	if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+		return fmt.Sprintf("%q is not a positive duration such as 72h", v)
+	}
+	return ""
+}
+
+// validIdentifier applies the Postgres rules for role and database names.
+func validIdentifier(v string) string {
+	switch {
+	case v == "":
+		return "must not be empty"
+	case len(v) > maxIdentifierLength:
+		return fmt.Sprintf("%q is longer than %d bytes", v, maxIdentifierLength)
+	case strings.ContainsRune(v, 0):
+		return fmt.Sprintf("%q contains a NUL character", v)
+	}
+	return ""
+}
+
+// validRoleName additionally rejects the pg_ prefix, which Postgres reserves for its own
+// roles.
+func validRoleName(v string) string {
+	if reason := validIdentifier(v); reason != "" {
+		return reason
+	}
+	if strings.HasPrefix(v, "pg_") {
+		return fmt.Sprintf("%q uses the pg_ prefix reserved for system roles", v)
+	}
+	return ""
+}
@@ -2,755 +2,1092 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 )
 
 const (
-	baseURL = "https://console.neon.tech/api/v2"
+	defaultBaseURL = "https://console.neon.tech/api/v2"
+	defaultTimeout = 30 * time.Second
 )
 
+// Client is the single transport every resource uses to talk to the Neon API. It owns
+// the base URL, authentication, user agent and error decoding so that all requests
+// behave the same way, and so that the provider can be pointed at a fake API in tests.
 type Client struct {
 	apiKey     string
+	baseURL    string
+	userAgent  string
 	httpClient *http.Client
+
+	maxRetries   int
+	retryTimeout time.Duration
+	minBackoff   time.Duration
+	maxBackoff   time.Duration
+	pollInterval time.Duration
+
+	// orgId is the organization new projects are created in and listed from when the
+	// caller does not name one.
+	orgId string
+
+	preflight preflightCache
 }
 
-func NewClient(apiKey string) *Client {
-	return &Client{
-		apiKey: apiKey,
+// ClientOption customizes a Client built by NewClient.
+type ClientOption func(*Client)
+
+// WithBaseURL points the client at a Neon API other than the public one.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithHTTPClient replaces the underlying HTTP client.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTimeout sets the timeout applied to each individual HTTP request.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.httpClient.Timeout = timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithMaxRetries sets how many times a failed request is retried. Zero disables retries.
+func WithMaxRetries(maxRetries int) ClientOption {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+	}
+}
+
+// WithRetryTimeout bounds the total time spent on a request, including every retry.
+func WithRetryTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.retryTimeout = timeout
+	}
+}
+
+// WithOrgID sets the organization used for projects that do not name one. Org API keys
+// are already scoped to their organization and do not need it.
+func WithOrgID(orgId string) ClientOption {
+	return func(c *Client) {
+		c.orgId = orgId
+	}
+}
+
+func NewClient(apiKey string, opts ...ClientOption) *Client {
+	c := &Client{
+		apiKey:    apiKey,
+		baseURL:   defaultBaseURL,
+		userAgent: "pulumi-neon",
 		httpClient: &http.Client{
-			Timeout: time.Second * 10,
+			Timeout: defaultTimeout,
 		},
+		maxRetries:   defaultMaxRetries,
+		retryTimeout: defaultRetryTimeout,
+		minBackoff:   defaultMinBackoff,
+		maxBackoff:   defaultMaxBackoff,
+		pollInterval: defaultPollInterval,
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
 
-func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error) {
-	url := fmt.Sprintf("%s%s", baseURL, path)
-	log.Printf("Making request to URL: %s", url)
+// doRequest sends a JSON request to the Neon API and decodes the response into out,
+// which may be nil when the response body is not needed. Requests that Neon rejects as
+// locked, rate limited or temporarily unavailable are retried with jittered exponential
+// backoff until the client's retry budget or deadline runs out.
+func (c *Client) doRequest(ctx context.Context, method, path string, body, out interface{}) error {
+	url := c.baseURL + path
 
-	var reqBody io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
-			return nil, fmt.Errorf("error marshalling request body: %v", err)
+			return fmt.Errorf("error marshalling request body: %v", err)
+		}
+	}
+
+	deadline := time.Now().Add(c.retryTimeout)
+	for attempt := 0; ; attempt++ {
+		log.Printf("Making request: %s %s", method, url)
+		respBody, wait, err := c.send(ctx, method, url, jsonBody)
+		if err == nil {
+			if out == nil || len(respBody) == 0 {
+				return nil
+			}
+			if err := json.Unmarshal(respBody, out); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %v", err)
+			}
+			return nil
+		}
+
+		if wait < 0 || attempt >= c.maxRetries {
+			return err
+		}
+		if wait == 0 {
+			wait = c.backoff(attempt)
+		}
+		if time.Now().Add(wait).After(deadline) {
+			return fmt.Errorf("giving up after %d attempts: %w", attempt+1, err)
+		}
+
+		log.Printf("Retrying %s %s in %s: %v", method, url, wait, err)
+		if err := sleep(ctx, wait); err != nil {
+			return err
 		}
-		reqBody = bytes.NewBuffer(jsonBody)
-		log.Printf("Request body: %s", string(jsonBody))
 	}
+}
 
-	req, err := http.NewRequest(method, url, reqBody)
+// send performs a single HTTP exchange. On failure it also returns how long to wait
+// before retrying: a negative wait means the error is final, zero means the caller should
+// pick a backoff, and a positive wait comes from the server's Retry-After header.
+func (c *Client) send(ctx context.Context, method, url string, jsonBody []byte) ([]byte, time.Duration, error) {
+	var reqBody io.Reader
+	if jsonBody != nil {
+		reqBody = bytes.NewReader(jsonBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+		return nil, -1, fmt.Errorf("error creating request: %v", err)
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
 	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	if jsonBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("error sending request: %v", err)
+		if ctx.Err() != nil || !isIdempotent(method) {
+			return nil, -1, fmt.Errorf("error sending request: %v", err)
+		}
+		return nil, 0, fmt.Errorf("error sending request: %v", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %v", err)
+		return nil, -1, fmt.Errorf("error reading response body: %v", err)
 	}
 
 	log.Printf("Response status: %d", resp.StatusCode)
-	log.Printf("Response body: %s", string(respBody))
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+		err := decodeError(resp, respBody)
+		if !shouldRetry(method, err) {
+			return nil, -1, err
+		}
+		if wait, ok := retryAfter(resp); ok {
+			return nil, wait, err
+		}
+		return nil, 0, err
 	}
 
-	return respBody, nil
+	return respBody, 0, nil
 }
 
-func (c *Client) CreateProject(name, regionId string) (*ProjectState, error) {
-	body := struct {
-		Project struct {
-			Name     string `json:"name"`
-			RegionId string `json:"region_id"`
-		} `json:"project"`
-	}{
-		Project: struct {
-			Name     string `json:"name"`
-			RegionId string `json:"region_id"`
-		}{
-			Name:     name,
-			RegionId: regionId,
-		},
+// doOperation sends a mutating request and, once it succeeds, waits for the operations
+// Neon started in response so that dependent resources see a settled project. If waiting
+// fails, out is still filled in and the error is an *OperationError.
+func (c *Client) doOperation(ctx context.Context, method, path, projectId string, body, out interface{}) error {
+	operations, err := c.startOperations(ctx, method, path, body, out)
+	if err != nil {
+		return err
 	}
+	return c.finishOperations(ctx, projectId, operations)
+}
 
-	resp, err := c.doRequest("POST", "/projects", body)
-	if err != nil {
+// startOperations sends a mutating request, decodes its response into out and returns
+// the operations Neon started in response.
+func (c *Client) startOperations(ctx context.Context, method, path string, body, out interface{}) ([]apiOperation, error) {
+	var raw json.RawMessage
+	if err := c.doRequest(ctx, method, path, body, &raw); err != nil {
 		return nil, err
 	}
-
-	var result struct {
-		Project struct {
-			Id        string `json:"id"`
-			Name      string `json:"name"`
-			RegionId  string `json:"region_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"project"`
+	if len(raw) == 0 {
+		return nil, nil
 	}
 
-	err = json.Unmarshal(resp, &result)
-	if err != nil {
-		return nil, err
+	if out != nil {
+		if err := json.Unmarshal(raw, out); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %v", err)
+		}
 	}
 
-	return &ProjectState{
-		ProjectArgs: ProjectArgs{
-			Name:     result.Project.Name,
-			RegionId: result.Project.RegionId,
-		},
-		Id:        result.Project.Id,
-		CreatedAt: result.Project.CreatedAt,
-	}, nil
+	var result struct {
+		Operations []apiOperation `json:"operations"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %v", err)
+	}
+	return result.Operations, nil
 }
 
-func (c *Client) GetProject(projectId string) (*ProjectState, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/projects/%s", projectId), nil)
-	if err != nil {
-		return nil, err
+// finishOperations waits for operations of projectId, reporting a failure as an
+// *OperationError.
+func (c *Client) finishOperations(ctx context.Context, projectId string, operations []apiOperation) error {
+	if err := c.waitForOperations(ctx, projectId, operations); err != nil {
+		return &OperationError{Err: err}
 	}
+	return nil
+}
 
-	var result struct {
-		Project struct {
-			Id        string `json:"id"`
-			Name      string `json:"name"`
-			RegionId  string `json:"region_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"project"`
-	}
+type apiProject struct {
+	Id                      string               `json:"id"`
+	Name                    string               `json:"name"`
+	RegionId                string               `json:"region_id"`
+	OrgId                   string               `json:"org_id"`
+	PgVersion               int                  `json:"pg_version"`
+	Provisioner             string               `json:"provisioner"`
+	StorePasswords          bool                 `json:"store_passwords"`
+	HistoryRetentionSeconds int                  `json:"history_retention_seconds"`
+	DefaultEndpointSettings *apiEndpointSettings `json:"default_endpoint_settings"`
+	CreatedAt               string               `json:"created_at"`
+}
 
-	err = json.Unmarshal(resp, &result)
-	if err != nil {
-		return nil, err
-	}
+// apiEndpointSettings is both sent and received, so unset fields are omitted.
+type apiEndpointSettings struct {
+	AutoscalingLimitMinCu *float64 `json:"autoscaling_limit_min_cu,omitempty"`
+	AutoscalingLimitMaxCu *float64 `json:"autoscaling_limit_max_cu,omitempty"`
+	SuspendTimeoutSeconds *int     `json:"suspend_timeout_seconds,omitempty"`
+}
 
-	return &ProjectState{
+func (p apiProject) state() *ProjectState {
+	state := &ProjectState{
 		ProjectArgs: ProjectArgs{
-			Name:     result.Project.Name,
-			RegionId: result.Project.RegionId,
-		},
-		Id:        result.Project.Id,
-		CreatedAt: result.Project.CreatedAt,
-	}, nil
-}
-
-func (c *Client) UpdateProject(projectId string, name string) (*ProjectState, error) {
-	body := struct {
-		Project struct {
-			Name string `json:"name"`
-		} `json:"project"`
-	}{
-		Project: struct {
-			Name string `json:"name"`
-		}{
-			Name: name,
+			Name:                    p.Name,
+			RegionId:                p.RegionId,
+			StorePasswords:          &p.StorePasswords,
+			HistoryRetentionSeconds: &p.HistoryRetentionSeconds,
 		},
+		ProjectId: p.Id,
+		CreatedAt: p.CreatedAt,
 	}
-
-	resp, err := c.doRequest("PATCH", fmt.Sprintf("/projects/%s", projectId), body)
-	if err != nil {
-		return nil, err
+	if p.OrgId != "" {
+		state.OrgId = &p.OrgId
 	}
-
-	var result struct {
-		Project struct {
-			Id        string `json:"id"`
-			Name      string `json:"name"`
-			RegionId  string `json:"region_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"project"`
+	if p.PgVersion != 0 {
+		state.PgVersion = &p.PgVersion
 	}
-
-	err = json.Unmarshal(resp, &result)
-	if err != nil {
-		return nil, err
+	if p.Provisioner != "" {
+		state.Provisioner = &p.Provisioner
+	}
+	if s := p.DefaultEndpointSettings; s != nil {
+		state.DefaultEndpointSettings = &DefaultEndpointSettings{
+			AutoscalingLimitMinCu: s.AutoscalingLimitMinCu,
+			AutoscalingLimitMaxCu: s.AutoscalingLimitMaxCu,
+			SuspendTimeoutSeconds: s.SuspendTimeoutSeconds,
+		}
 	}
+	return state
+}
 
-	return &ProjectState{
-		ProjectArgs: ProjectArgs{
-			Name:     result.Project.Name,
-			RegionId: result.Project.RegionId,
-		},
-		Id:        result.Project.Id,
-		CreatedAt: result.Project.CreatedAt,
-	}, nil
+// endpointSettings converts the project's default endpoint settings to their wire form.
+func (args ProjectArgs) endpointSettings() *apiEndpointSettings {
+	s := args.DefaultEndpointSettings
+	if s == nil {
+		return nil
+	}
+	return &apiEndpointSettings{
+		AutoscalingLimitMinCu: s.AutoscalingLimitMinCu,
+		AutoscalingLimitMaxCu: s.AutoscalingLimitMaxCu,
+		SuspendTimeoutSeconds: s.SuspendTimeoutSeconds,
+	}
 }
 
-func (c *Client) DeleteProject(projectId string) error {
-	_, err := c.doRequest("DELETE", fmt.Sprintf("/projects/%s", projectId), nil)
-	return err
+// apiEndpointOptions describes an endpoint to create along with a branch.
+type apiEndpointOptions struct {
+	Type        string  `json:"type"`
+	Provisioner *string `json:"provisioner,omitempty"`
+	apiEndpointSettings
 }
 
-func (c *Client) CreateBranchDirect(projectId, name string) (*BranchState, error) {
-	url := fmt.Sprintf("%s/projects/%s/branches", baseURL, projectId)
-	payload := strings.NewReader(fmt.Sprintf(`{"branch":{"name":"%s"},"endpoints":[{"type":"read_only"}]}`, name))
+type apiBranch struct {
+	Id              string  `json:"id"`
+	Name            string  `json:"name"`
+	Default         bool    `json:"default"`
+	ProjectId       string  `json:"project_id"`
+	ParentId        *string `json:"parent_id"`
+	ParentLsn       *string `json:"parent_lsn"`
+	ParentTimestamp *string `json:"parent_timestamp"`
+	ExpiresAt       *string `json:"expires_at"`
+	CreatedAt       string  `json:"created_at"`
+}
 
-	req, err := http.NewRequest("POST", url, payload)
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %v", err)
+func (b apiBranch) state() *BranchState {
+	return &BranchState{
+		BranchArgs: BranchArgs{
+			ProjectId:       b.ProjectId,
+			Name:            b.Name,
+			ParentId:        b.ParentId,
+			ParentLsn:       b.ParentLsn,
+			ParentTimestamp: b.ParentTimestamp,
+			ExpiresAt:       b.ExpiresAt,
+		},
+		BranchId:  b.Id,
+		CreatedAt: b.CreatedAt,
 	}
+}
 
-	req.Header.Add("accept", "application/json")
-	req.Header.Add("content-type", "application/json")
-	req.Header.Add("authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+type apiEndpoint struct {
+	Id                    string  `json:"id"`
+	Host                  string  `json:"host"`
+	ProjectId             string  `json:"project_id"`
+	BranchId              string  `json:"branch_id"`
+	Type                  string  `json:"type"`
+	AutoscalingLimitMinCu float64 `json:"autoscaling_limit_min_cu"`
+	AutoscalingLimitMaxCu float64 `json:"autoscaling_limit_max_cu"`
+	SuspendTimeoutSeconds int     `json:"suspend_timeout_seconds"`
+	PoolerEnabled         bool    `json:"pooler_enabled"`
+	PoolerMode            string  `json:"pooler_mode"`
+	RegionId              string  `json:"region_id"`
+	Provisioner           string  `json:"provisioner"`
+	ProxyHost             string  `json:"proxy_host"`
+	CurrentState          string  `json:"current_state"`
+	LastActive            string  `json:"last_active"`
+	Settings              struct {
+		PgSettings map[string]string `json:"pg_settings"`
+	} `json:"settings"`
+	CreatedAt string `json:"created_at"`
+}
 
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error sending request: %v", err)
+func (e apiEndpoint) state() *EndpointState {
+	state := &EndpointState{
+		EndpointArgs: EndpointArgs{
+			ProjectId:             e.ProjectId,
+			BranchId:              e.BranchId,
+			Type:                  e.Type,
+			AutoscalingLimitMinCu: &e.AutoscalingLimitMinCu,
+			AutoscalingLimitMaxCu: &e.AutoscalingLimitMaxCu,
+			SuspendTimeoutSeconds: &e.SuspendTimeoutSeconds,
+			PoolerEnabled:         &e.PoolerEnabled,
+			Settings:              e.Settings.PgSettings,
+		},
+		EndpointId:   e.Id,
+		Host:         e.Host,
+		PoolerHost:   poolerHost(e.Id, e.Host),
+		Port:         postgresPort,
+		ProxyHost:    e.ProxyHost,
+		CurrentState: e.CurrentState,
+		LastActive:   e.LastActive,
+		CreatedAt:    e.CreatedAt,
 	}
-	defer res.Body.Close()
-
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %v", err)
+	if e.PoolerMode != "" {
+		state.PoolerMode = &e.PoolerMode
+	}
+	if e.RegionId != "" {
+		state.RegionId = &e.RegionId
 	}
+	if e.Provisioner != "" {
+		state.Provisioner = &e.Provisioner
+	}
+	return state
+}
 
-	log.Printf("CreateBranchDirect: Response status: %d", res.StatusCode)
-	log.Printf("CreateBranchDirect: Response body: %s", string(body))
+// postgresPort is the port every Neon endpoint and pooler listens on.
+const postgresPort = 5432
 
-	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		return nil, fmt.Errorf("API request failed with status %d: %s", res.StatusCode, string(body))
+// poolerHost derives the pooled hostname of an endpoint, which Neon forms by adding
+// -pooler to the endpoint ID in its hostname.
+func poolerHost(endpointId, host string) string {
+	if endpointId == "" || !strings.HasPrefix(host, endpointId) {
+		return ""
 	}
+	return endpointId + "-pooler" + strings.TrimPrefix(host, endpointId)
+}
 
-	var result struct {
-		Branch struct {
-			Id        string `json:"id"`
-			Name      string `json:"name"`
-			ProjectId string `json:"project_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"branch"`
+// endpointRequest builds the body of an endpoint create or update. Neon only accepts a
+// type and region when the endpoint is created.
+func endpointRequest(args EndpointArgs, create bool) map[string]interface{} {
+	endpoint := map[string]interface{}{
+		"branch_id": args.BranchId,
 	}
-
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %v", err)
+	if create {
+		endpoint["type"] = args.Type
+		if args.RegionId != nil {
+			endpoint["region_id"] = *args.RegionId
+		}
+	}
+	if args.AutoscalingLimitMinCu != nil {
+		endpoint["autoscaling_limit_min_cu"] = *args.AutoscalingLimitMinCu
+	}
+	if args.AutoscalingLimitMaxCu != nil {
+		endpoint["autoscaling_limit_max_cu"] = *args.AutoscalingLimitMaxCu
+	}
+	if args.SuspendTimeoutSeconds != nil {
+		endpoint["suspend_timeout_seconds"] = *args.SuspendTimeoutSeconds
+	}
+	if args.PoolerEnabled != nil {
+		endpoint["pooler_enabled"] = *args.PoolerEnabled
+	}
+	if args.PoolerMode != nil {
+		endpoint["pooler_mode"] = *args.PoolerMode
+	}
+	if args.Provisioner != nil {
+		endpoint["provisioner"] = *args.Provisioner
+	}
+	if args.Settings != nil {
+		endpoint["settings"] = map[string]interface{}{
+			"pg_settings": args.Settings,
+		}
+	}
+	return map[string]interface{}{
+		"endpoint": endpoint,
 	}
+}
 
-	return &BranchState{
-		BranchArgs: BranchArgs{
-			ProjectId: result.Branch.ProjectId,
-			Name:      result.Branch.Name,
+type apiDatabase struct {
+	Id        int64  `json:"id"`
+	Name      string `json:"name"`
+	OwnerName string `json:"owner_name"`
+	BranchId  string `json:"branch_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+// Databases are not returned with their project, so the caller supplies it.
+func (d apiDatabase) state(projectId string) *DatabaseState {
+	return &DatabaseState{
+		DatabaseArgs: DatabaseArgs{
+			ProjectId: projectId,
+			BranchId:  d.BranchId,
+			Name:      d.Name,
+			OwnerName: d.OwnerName,
 		},
-		Id:        result.Branch.Id,
-		CreatedAt: result.Branch.CreatedAt,
-	}, nil
+		DatabaseId: fmt.Sprintf("%d", d.Id),
+		CreatedAt:  d.CreatedAt,
+	}
 }
 
-func (c *Client) CreateBranch(projectId, name string) (*BranchState, error) {
-	log.Printf("CreateBranch: Starting with projectId=%s, name=%s", projectId, name)
+type apiRole struct {
+	Name      string `json:"name"`
+	Password  string `json:"password"`
+	Protected bool   `json:"protected"`
+	CreatedAt string `json:"created_at"`
+}
 
-	// Log a masked version of the API key
-	maskedAPIKey := c.apiKey
-	if len(maskedAPIKey) > 8 {
-		maskedAPIKey = maskedAPIKey[:4] + "..." + maskedAPIKey[len(maskedAPIKey)-4:]
+// Roles are not returned with their project and branch, so the caller supplies them.
+func (r apiRole) state(projectId, branchId string) *RoleState {
+	return &RoleState{
+		RoleArgs: RoleArgs{
+			ProjectId: projectId,
+			BranchId:  branchId,
+			Name:      r.Name,
+		},
+		Password:  r.Password,
+		CreatedAt: r.CreatedAt,
 	}
-	log.Printf("CreateBranch: Using API key: %s", maskedAPIKey)
+}
 
-	// Validate API key
-	if len(c.apiKey) == 0 {
-		return nil, fmt.Errorf("API key is empty")
+// CreateProject creates a project. If only waiting for the operations it started fails,
+// the project is returned along with the *OperationError; the other Create methods do the
+// same, so that callers can keep track of what was created.
+func (c *Client) CreateProject(ctx context.Context, args ProjectArgs) (*ProjectState, error) {
+	project := map[string]interface{}{
+		"name":      args.Name,
+		"region_id": args.RegionId,
+	}
+	if orgId := c.projectOrgId(args.OrgId); orgId != "" {
+		project["org_id"] = orgId
+	}
+	if args.PgVersion != nil {
+		project["pg_version"] = *args.PgVersion
+	}
+	if args.Provisioner != nil {
+		project["provisioner"] = *args.Provisioner
+	}
+	if args.StorePasswords != nil {
+		project["store_passwords"] = *args.StorePasswords
+	}
+	if args.HistoryRetentionSeconds != nil {
+		project["history_retention_seconds"] = *args.HistoryRetentionSeconds
+	}
+	if settings := args.endpointSettings(); settings != nil {
+		project["default_endpoint_settings"] = settings
+	}
+	body := map[string]interface{}{
+		"project": project,
 	}
 
-	branchState, err := c.CreateBranchDirect(projectId, name)
+	var result struct {
+		Project        apiProject    `json:"project"`
+		Branch         apiBranch     `json:"branch"`
+		Endpoints      []apiEndpoint `json:"endpoints"`
+		Roles          []apiRole     `json:"roles"`
+		Databases      []apiDatabase `json:"databases"`
+		ConnectionUris []struct {
+			ConnectionUri string `json:"connection_uri"`
+		} `json:"connection_uris"`
+	}
+	// The project's ID is only known from the response, so its operations are waited
+	// for once it has been decoded.
+	operations, err := c.startOperations(ctx, http.MethodPost, "/projects", body, &result)
 	if err != nil {
-		log.Printf("CreateBranch: Error occurred: %v", err)
-		if strings.Contains(err.Error(), "branch already exists") {
-			log.Printf("CreateBranch: Branch already exists, attempting to fetch existing branch")
-			return c.GetBranch(projectId, name)
-		}
-		return nil, fmt.Errorf("failed to create branch: %v", err)
+		return nil, err
 	}
 
-	log.Printf("CreateBranch: Branch created successfully: id=%s", branchState.Id)
-	return branchState, nil
+	state := result.Project.state()
+	state.ProjectDefaults = projectDefaults(ProjectDefaults{}, result.Branch, result.Endpoints, result.Roles, result.Databases)
+	if len(result.ConnectionUris) > 0 {
+		state.ConnectionUri = result.ConnectionUris[0].ConnectionUri
+	}
+	return state, c.finishOperations(ctx, state.ProjectId, operations)
 }
 
-func (c *Client) GetBranch(projectId, branchId string) (*BranchState, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/projects/%s/branches/%s", projectId, branchId), nil)
-	if err != nil {
-		return nil, err
+// projectDefaults picks the read-write endpoint of the default branch, along with the
+// owner role and database Neon created on it. The role and database in current are kept
+// while they still exist, so that creating more of them does not change the defaults.
+func projectDefaults(current ProjectDefaults, branch apiBranch, endpoints []apiEndpoint, roles []apiRole, databases []apiDatabase) ProjectDefaults {
+	defaults := ProjectDefaults{DefaultBranchId: branch.Id}
+	for _, endpoint := range endpoints {
+		if endpoint.BranchId == branch.Id && endpoint.Type == "read_write" {
+			defaults.DefaultEndpointHost = endpoint.Host
+			break
+		}
+	}
+	for _, role := range roles {
+		if role.Name == current.DefaultRoleName {
+			defaults.DefaultRoleName = role.Name
+			break
+		}
+		if !role.Protected && defaults.DefaultRoleName == "" {
+			defaults.DefaultRoleName = role.Name
+		}
 	}
+	for _, database := range databases {
+		if database.Name == current.DefaultDatabaseName {
+			defaults.DefaultDatabaseName = database.Name
+			break
+		}
+		if defaults.DefaultDatabaseName == "" {
+			defaults.DefaultDatabaseName = database.Name
+		}
+	}
+	return defaults
+}
 
-	var result struct {
-		Branch struct {
-			Id        string `json:"id"`
-			Name      string `json:"name"`
-			ProjectId string `json:"project_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"branch"`
+// GetProjectDefaults looks up the objects that Neon created along with a project,
+// preferring the role and database in current.
+func (c *Client) GetProjectDefaults(ctx context.Context, projectId string, current ProjectDefaults) (*ProjectDefaults, error) {
+	var branches struct {
+		Branches []apiBranch `json:"branches"`
+	}
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/branches", projectId), nil, &branches); err != nil {
+		return nil, err
+	}
+	var branch apiBranch
+	for _, b := range branches.Branches {
+		if b.Default {
+			branch = b
+			break
+		}
+	}
+	if branch.Id == "" {
+		return &ProjectDefaults{}, nil
 	}
 
-	err = json.Unmarshal(resp, &result)
-	if err != nil {
+	var endpoints struct {
+		Endpoints []apiEndpoint `json:"endpoints"`
+	}
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/endpoints", projectId), nil, &endpoints); err != nil {
+		return nil, err
+	}
+	var roles struct {
+		Roles []apiRole `json:"roles"`
+	}
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/branches/%s/roles", projectId, branch.Id), nil, &roles); err != nil {
+		return nil, err
+	}
+	var databases struct {
+		Databases []apiDatabase `json:"databases"`
+	}
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/branches/%s/databases", projectId, branch.Id), nil, &databases); err != nil {
 		return nil, err
 	}
 
-	return &BranchState{
-		BranchArgs: BranchArgs{
-			ProjectId: result.Branch.ProjectId,
-			Name:      result.Branch.Name,
-		},
-		Id:        result.Branch.Id,
-		CreatedAt: result.Branch.CreatedAt,
-	}, nil
-}
-
-func (c *Client) UpdateBranch(projectId, branchId, name string) (*BranchState, error) {
-	body := struct {
-		Branch struct {
-			Name string `json:"name"`
-		} `json:"branch"`
-	}{
-		Branch: struct {
-			Name string `json:"name"`
-		}{
-			Name: name,
-		},
+	defaults := projectDefaults(current, branch, endpoints.Endpoints, roles.Roles, databases.Databases)
+	if defaults.DefaultEndpointHost != "" && defaults.DefaultRoleName != "" && defaults.DefaultDatabaseName != "" {
+		uri, err := c.GetConnectionURI(ctx, projectId, branch.Id, "", defaults.DefaultDatabaseName, defaults.DefaultRoleName, false)
+		switch {
+		case err == nil:
+			defaults.ConnectionUri = uri
+		case isClientError(err):
+			// Neon cannot build the URI for projects that do not store passwords, so the
+			// one known from creation, if any, is kept.
+			log.Printf("Keeping the connection URI of project %s: %v", projectId, err)
+			defaults.ConnectionUri = current.ConnectionUri
+		default:
+			return nil, err
+		}
 	}
+	return &defaults, nil
+}
 
-	resp, err := c.doRequest("PATCH", fmt.Sprintf("/projects/%s/branches/%s", projectId, branchId), body)
-	if err != nil {
-		return nil, err
+// GetConnectionURI returns a Postgres connection URI, including the role's password, for
+// a database on a branch. An empty endpointId uses the branch's read-write endpoint, and
+// pooled URIs go through Neon's connection pooler.
+func (c *Client) GetConnectionURI(ctx context.Context, projectId, branchId, endpointId, databaseName, roleName string, pooled bool) (string, error) {
+	query := url.Values{}
+	query.Set("branch_id", branchId)
+	if endpointId != "" {
+		query.Set("endpoint_id", endpointId)
+	}
+	query.Set("database_name", databaseName)
+	query.Set("role_name", roleName)
+	if pooled {
+		query.Set("pooled", "true")
 	}
 
 	var result struct {
-		Branch struct {
-			Id        string `json:"id"`
-			Name      string `json:"name"`
-			ProjectId string `json:"project_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"branch"`
+		Uri string `json:"uri"`
+	}
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/connection_uri?%s", projectId, query.Encode()), nil, &result); err != nil {
+		return "", err
 	}
+	return result.Uri, nil
+}
 
-	err = json.Unmarshal(resp, &result)
-	if err != nil {
-		return nil, err
+// projectOrgId returns orgId, or the client's default organization when it is unset.
+func (c *Client) projectOrgId(orgId *string) string {
+	if orgId != nil {
+		return *orgId
 	}
+	return c.orgId
+}
 
-	return &BranchState{
-		BranchArgs: BranchArgs{
-			ProjectId: result.Branch.ProjectId,
-			Name:      result.Branch.Name,
-		},
-		Id:        result.Branch.Id,
-		CreatedAt: result.Branch.CreatedAt,
-	}, nil
-}
-
-func (c *Client) DeleteBranch(projectId, branchId string) error {
-	_, err := c.doRequest("DELETE", fmt.Sprintf("/projects/%s/branches/%s", projectId, branchId), nil)
-	return err
-}
-
-func (c *Client) CreateEndpoint(projectId, branchId, endpointType string) (*EndpointState, error) {
-	body := struct {
-		Endpoint struct {
-			BranchId string `json:"branch_id"`
-			Type     string `json:"type"`
-		} `json:"endpoint"`
-	}{
-		Endpoint: struct {
-			BranchId string `json:"branch_id"`
-			Type     string `json:"type"`
-		}{
-			BranchId: branchId,
-			Type:     endpointType,
-		},
+// projectsPageSize is the largest page of projects Neon returns.
+const projectsPageSize = 400
+
+// ListProjects returns every project in an organization, or in the client's default
+// organization when orgId is nil. Without either, personal API keys list the user's own
+// projects and org API keys list their organization's.
+func (c *Client) ListProjects(ctx context.Context, orgId *string) ([]*ProjectState, error) {
+	query := url.Values{}
+	query.Set("limit", fmt.Sprintf("%d", projectsPageSize))
+	if orgId := c.projectOrgId(orgId); orgId != "" {
+		query.Set("org_id", orgId)
+	}
+
+	var projects []*ProjectState
+	for {
+		var result struct {
+			Projects   []apiProject `json:"projects"`
+			Pagination struct {
+				Cursor string `json:"cursor"`
+			} `json:"pagination"`
+		}
+		if err := c.doRequest(ctx, http.MethodGet, "/projects?"+query.Encode(), nil, &result); err != nil {
+			return nil, err
+		}
+		for _, project := range result.Projects {
+			projects = append(projects, project.state())
+		}
+		cursor := result.Pagination.Cursor
+		if len(result.Projects) < projectsPageSize || cursor == "" || cursor == query.Get("cursor") {
+			return projects, nil
+		}
+		query.Set("cursor", cursor)
 	}
+}
 
-	resp, err := c.doRequest("POST", fmt.Sprintf("/projects/%s/endpoints", projectId), body)
-	if err != nil {
+func (c *Client) GetProject(ctx context.Context, projectId string) (*ProjectState, error) {
+	var result struct {
+		Project apiProject `json:"project"`
+	}
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s", projectId), nil, &result); err != nil {
 		return nil, err
 	}
+	return result.Project.state(), nil
+}
+
+// UpdateProject changes the settings Neon allows to be changed on an existing project:
+// its name, history retention and default endpoint settings.
+func (c *Client) UpdateProject(ctx context.Context, projectId string, args ProjectArgs) (*ProjectState, error) {
+	project := map[string]interface{}{
+		"name": args.Name,
+	}
+	if args.HistoryRetentionSeconds != nil {
+		project["history_retention_seconds"] = *args.HistoryRetentionSeconds
+	}
+	if settings := args.endpointSettings(); settings != nil {
+		project["default_endpoint_settings"] = settings
+	}
+	body := map[string]interface{}{
+		"project": project,
+	}
 
 	var result struct {
-		Endpoint struct {
-			Id        string `json:"id"`
-			Host      string `json:"host"`
-			ProjectId string `json:"project_id"`
-			BranchId  string `json:"branch_id"`
-			Type      string `json:"type"`
-			CreatedAt string `json:"created_at"`
-		} `json:"endpoint"`
+		Project apiProject `json:"project"`
 	}
-
-	err = json.Unmarshal(resp, &result)
-	if err != nil {
+	if err := c.doOperation(ctx, http.MethodPatch, fmt.Sprintf("/projects/%s", projectId), projectId, body, &result); err != nil {
 		return nil, err
 	}
+	return result.Project.state(), nil
+}
 
-	return &EndpointState{
-		EndpointArgs: EndpointArgs{
-			ProjectId: result.Endpoint.ProjectId,
-			BranchId:  result.Endpoint.BranchId,
-			Type:      result.Endpoint.Type,
-		},
-		Id:        result.Endpoint.Id,
-		Host:      result.Endpoint.Host,
-		CreatedAt: result.Endpoint.CreatedAt,
-	}, nil
+func (c *Client) DeleteProject(ctx context.Context, projectId string) error {
+	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/projects/%s", projectId), nil, nil)
 }
 
-func (c *Client) GetEndpoint(projectId, endpointId string) (*EndpointState, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/projects/%s/endpoints/%s", projectId, endpointId), nil)
+func (c *Client) CreateBranch(ctx context.Context, args BranchArgs) (*BranchState, error) {
+	projectId, name := args.ProjectId, args.Name
+	log.Printf("CreateBranch: Starting with projectId=%s, name=%s", projectId, name)
+
+	branch := map[string]string{
+		"name": name,
+	}
+	if args.ParentId != nil {
+		branch["parent_id"] = *args.ParentId
+	}
+	if args.ParentLsn != nil {
+		branch["parent_lsn"] = *args.ParentLsn
+	}
+	if args.ParentTimestamp != nil {
+		branch["parent_timestamp"] = *args.ParentTimestamp
+	}
+	expiresAt, err := args.expiration(time.Now())
 	if err != nil {
 		return nil, err
 	}
+	if expiresAt != nil {
+		branch["expires_at"] = *expiresAt
+	}
+	body := map[string]interface{}{
+		"branch": branch,
+	}
+	if len(args.Endpoints) > 0 {
+		endpoints := make([]apiEndpointOptions, len(args.Endpoints))
+		for i, endpoint := range args.Endpoints {
+			endpoints[i] = apiEndpointOptions{
+				Type:        endpoint.Type,
+				Provisioner: endpoint.Provisioner,
+				apiEndpointSettings: apiEndpointSettings{
+					AutoscalingLimitMinCu: endpoint.AutoscalingLimitMinCu,
+					AutoscalingLimitMaxCu: endpoint.AutoscalingLimitMaxCu,
+					SuspendTimeoutSeconds: endpoint.SuspendTimeoutSeconds,
+				},
+			}
+		}
+		body["endpoints"] = endpoints
+	}
 
 	var result struct {
-		Endpoint struct {
-			Id        string `json:"id"`
-			Host      string `json:"host"`
-			ProjectId string `json:"project_id"`
-			BranchId  string `json:"branch_id"`
-			Type      string `json:"type"`
-			CreatedAt string `json:"created_at"`
-		} `json:"endpoint"`
+		Branch    apiBranch     `json:"branch"`
+		Endpoints []apiEndpoint `json:"endpoints"`
 	}
-
-	err = json.Unmarshal(resp, &result)
-	if err != nil {
+	err = c.doOperation(ctx, http.MethodPost, fmt.Sprintf("/projects/%s/branches", projectId), projectId, body, &result)
+	if err != nil && !IsOperationFailed(err) {
+		log.Printf("CreateBranch: Error occurred: %v", err)
+		if IsConflict(err) {
+			// The existing branch was not created by this resource, so it is not taken over:
+			// replacing or destroying the resource would delete it. Importing it is explicit.
+			branchId := "<branchId>"
+			if existing, lookupErr := c.GetBranchByName(ctx, projectId, name); lookupErr == nil {
+				branchId = existing.BranchId
+			}
+			return nil, fmt.Errorf("a branch named %q already exists; to manage it with Pulumi, run `pulumi import neon:index:Branch <resourceName> %s/%s`: %w",
+				name, projectId, branchId, err)
+		}
 		return nil, err
 	}
 
-	return &EndpointState{
-		EndpointArgs: EndpointArgs{
-			ProjectId: result.Endpoint.ProjectId,
-			BranchId:  result.Endpoint.BranchId,
-			Type:      result.Endpoint.Type,
-		},
-		Id:        result.Endpoint.Id,
-		Host:      result.Endpoint.Host,
-		CreatedAt: result.Endpoint.CreatedAt,
-	}, nil
-}
-
-func (c *Client) UpdateEndpoint(projectId, endpointId string, branchId string, endpointType string) (*EndpointState, error) {
-	body := struct {
-		Endpoint struct {
-			BranchId string `json:"branch_id"`
-			Type     string `json:"type"`
-		} `json:"endpoint"`
-	}{
-		Endpoint: struct {
-			BranchId string `json:"branch_id"`
-			Type     string `json:"type"`
-		}{
-			BranchId: branchId,
-			Type:     endpointType,
-		},
+	log.Printf("CreateBranch: Branch created: id=%s", result.Branch.Id)
+	state := result.Branch.state()
+	state.Endpoints = args.Endpoints
+	state.Ttl = args.Ttl
+	for _, endpoint := range result.Endpoints {
+		state.CreatedEndpoints = append(state.CreatedEndpoints, CreatedEndpoint{
+			EndpointId: endpoint.Id,
+			Host:       endpoint.Host,
+			Type:       endpoint.Type,
+		})
 	}
+	return state, err
+}
 
-	resp, err := c.doRequest("PATCH", fmt.Sprintf("/projects/%s/endpoints/%s", projectId, endpointId), body)
-	if err != nil {
+func (c *Client) GetBranch(ctx context.Context, projectId, branchId string) (*BranchState, error) {
+	var result struct {
+		Branch apiBranch `json:"branch"`
+	}
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/branches/%s", projectId, branchId), nil, &result); err != nil {
 		return nil, err
 	}
+	return result.Branch.state(), nil
+}
 
+// GetBranchByName finds the branch called name in a project. Branch names are not part
+// of the API path, so this lists the project's branches.
+func (c *Client) GetBranchByName(ctx context.Context, projectId, name string) (*BranchState, error) {
 	var result struct {
-		Endpoint struct {
-			Id        string `json:"id"`
-			Host      string `json:"host"`
-			ProjectId string `json:"project_id"`
-			BranchId  string `json:"branch_id"`
-			Type      string `json:"type"`
-			CreatedAt string `json:"created_at"`
-		} `json:"endpoint"`
+		Branches []apiBranch `json:"branches"`
 	}
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/branches", projectId), nil, &result); err != nil {
+		return nil, err
+	}
+	for _, branch := range result.Branches {
+		if branch.Name == name {
+			return branch.state(), nil
+		}
+	}
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("branch %q not found in project %s", name, projectId),
+	}
+}
 
-	err = json.Unmarshal(resp, &result)
-	if err != nil {
+// ListBranchEndpoints returns the endpoints attached to a branch.
+func (c *Client) ListBranchEndpoints(ctx context.Context, projectId, branchId string) ([]apiEndpoint, error) {
+	var result struct {
+		Endpoints []apiEndpoint `json:"endpoints"`
+	}
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/branches/%s/endpoints", projectId, branchId), nil, &result); err != nil {
 		return nil, err
 	}
+	return result.Endpoints, nil
+}
 
-	return &EndpointState{
-		EndpointArgs: EndpointArgs{
-			ProjectId: result.Endpoint.ProjectId,
-			BranchId:  result.Endpoint.BranchId,
-			Type:      result.Endpoint.Type,
-		},
-		Id:        result.Endpoint.Id,
-		Host:      result.Endpoint.Host,
-		CreatedAt: result.Endpoint.CreatedAt,
-	}, nil
-}
-
-func (c *Client) DeleteEndpoint(projectId, endpointId string) error {
-	_, err := c.doRequest("DELETE", fmt.Sprintf("/projects/%s/endpoints/%s", projectId, endpointId), nil)
-	return err
-}
-
-func (c *Client) CreateDatabase(projectId, branchId, name string) (*DatabaseState, error) {
-	log.Printf("Creating database: projectId=%s, branchId=%s, name=%s", projectId, branchId, name)
-	body := struct {
-		Database struct {
-			Name      string `json:"name"`
-			OwnerName string `json:"owner_name"`
-		} `json:"database"`
-	}{
-		Database: struct {
-			Name      string `json:"name"`
-			OwnerName string `json:"owner_name"`
-		}{
-			Name:      name,
-			OwnerName: "default",
+// UpdateBranch renames a branch and sets when it expires. A nil expiresAt removes the
+// expiration.
+func (c *Client) UpdateBranch(ctx context.Context, projectId, branchId, name string, expiresAt *string) (*BranchState, error) {
+	body := map[string]interface{}{
+		"branch": map[string]interface{}{
+			"name":       name,
+			"expires_at": expiresAt,
 		},
 	}
 
-	resp, err := c.doRequest("POST", fmt.Sprintf("/projects/%s/branches/%s/databases", projectId, branchId), body)
-	if err != nil {
-		log.Printf("Error creating database: %v", err)
+	var result struct {
+		Branch apiBranch `json:"branch"`
+	}
+	if err := c.doOperation(ctx, http.MethodPatch, fmt.Sprintf("/projects/%s/branches/%s", projectId, branchId), projectId, body, &result); err != nil {
 		return nil, err
 	}
+	return result.Branch.state(), nil
+}
 
-	var result struct {
-		Database struct {
-			Id        int64  `json:"id"`
-			Name      string `json:"name"`
-			OwnerName string `json:"owner_name"`
-			ProjectId string `json:"project_id"`
-			BranchId  string `json:"branch_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"database"`
+// RestoreBranch replaces a branch's data with that of sourceBranchId, at its head or as of
+// sourceLsn or sourceTimestamp. Restoring a branch from its parent's head resets it to
+// the parent. When preserveUnderName is set, the branch's current data is first saved as
+// a new branch with that name.
+func (c *Client) RestoreBranch(ctx context.Context, projectId, branchId, sourceBranchId string, sourceLsn, sourceTimestamp, preserveUnderName *string) (*BranchState, error) {
+	body := map[string]interface{}{
+		"source_branch_id": sourceBranchId,
+	}
+	if sourceLsn != nil {
+		body["source_lsn"] = *sourceLsn
+	}
+	if sourceTimestamp != nil {
+		body["source_timestamp"] = *sourceTimestamp
+	}
+	if preserveUnderName != nil {
+		body["preserve_under_name"] = *preserveUnderName
 	}
 
-	err = json.Unmarshal(resp, &result)
-	if err != nil {
-		log.Printf("Error unmarshalling response: %v", err)
+	var result struct {
+		Branch apiBranch `json:"branch"`
+	}
+	if err := c.doOperation(ctx, http.MethodPost, fmt.Sprintf("/projects/%s/branches/%s/restore", projectId, branchId), projectId, body, &result); err != nil {
 		return nil, err
 	}
+	return result.Branch.state(), nil
+}
 
-	log.Printf("Database created successfully: id=%d", result.Database.Id)
-	return &DatabaseState{
-		DatabaseArgs: DatabaseArgs{
-			ProjectId: result.Database.ProjectId,
-			BranchId:  result.Database.BranchId,
-			Name:      result.Database.Name,
-		},
-		Id:        fmt.Sprintf("%d", result.Database.Id),
-		CreatedAt: result.Database.CreatedAt,
-	}, nil
+func (c *Client) DeleteBranch(ctx context.Context, projectId, branchId string) error {
+	return c.doOperation(ctx, http.MethodDelete, fmt.Sprintf("/projects/%s/branches/%s", projectId, branchId), projectId, nil, nil)
 }
 
-func (c *Client) GetDatabase(projectId, branchId, databaseName string) (*DatabaseState, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/projects/%s/branches/%s/databases/%s", projectId, branchId, databaseName), nil)
-	if err != nil {
-		return nil, err
-	}
+func (c *Client) CreateEndpoint(ctx context.Context, args EndpointArgs) (*EndpointState, error) {
+	projectId := args.ProjectId
+	body := endpointRequest(args, true)
 
 	var result struct {
-		Database struct {
-			Id        int64  `json:"id"`
-			Name      string `json:"name"`
-			OwnerName string `json:"owner_name"`
-			ProjectId string `json:"project_id"`
-			BranchId  string `json:"branch_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"database"`
+		Endpoint apiEndpoint `json:"endpoint"`
 	}
-
-	err = json.Unmarshal(resp, &result)
-	if err != nil {
+	err := c.doOperation(ctx, http.MethodPost, fmt.Sprintf("/projects/%s/endpoints", projectId), projectId, body, &result)
+	if err != nil && !IsOperationFailed(err) {
 		return nil, err
 	}
+	return result.Endpoint.state(), err
+}
 
-	return &DatabaseState{
-		DatabaseArgs: DatabaseArgs{
-			ProjectId: result.Database.ProjectId,
-			BranchId:  result.Database.BranchId,
-			Name:      result.Database.Name,
-		},
-		Id:        fmt.Sprintf("%d", result.Database.Id),
-		CreatedAt: result.Database.CreatedAt,
-	}, nil
-}
-
-func (c *Client) UpdateDatabase(projectId, branchId, databaseName, newName string) (*DatabaseState, error) {
-	body := struct {
-		Database struct {
-			Name string `json:"name"`
-		} `json:"database"`
-	}{
-		Database: struct {
-			Name string `json:"name"`
-		}{
-			Name: newName,
-		},
+func (c *Client) GetEndpoint(ctx context.Context, projectId, endpointId string) (*EndpointState, error) {
+	var result struct {
+		Endpoint apiEndpoint `json:"endpoint"`
 	}
-
-	resp, err := c.doRequest("PATCH", fmt.Sprintf("/projects/%s/branches/%s/databases/%s", projectId, branchId, databaseName), body)
-	if err != nil {
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/endpoints/%s", projectId, endpointId), nil, &result); err != nil {
 		return nil, err
 	}
+	return result.Endpoint.state(), nil
+}
+
+func (c *Client) UpdateEndpoint(ctx context.Context, projectId, endpointId string, args EndpointArgs) (*EndpointState, error) {
+	body := endpointRequest(args, false)
 
 	var result struct {
-		Database struct {
-			Id        int64  `json:"id"`
-			Name      string `json:"name"`
-			OwnerName string `json:"owner_name"`
-			ProjectId string `json:"project_id"`
-			BranchId  string `json:"branch_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"database"`
+		Endpoint apiEndpoint `json:"endpoint"`
 	}
-
-	err = json.Unmarshal(resp, &result)
-	if err != nil {
+	if err := c.doOperation(ctx, http.MethodPatch, fmt.Sprintf("/projects/%s/endpoints/%s", projectId, endpointId), projectId, body, &result); err != nil {
 		return nil, err
 	}
+	return result.Endpoint.state(), nil
+}
 
-	return &DatabaseState{
-		DatabaseArgs: DatabaseArgs{
-			ProjectId: result.Database.ProjectId,
-			BranchId:  result.Database.BranchId,
-			Name:      result.Database.Name,
-		},
-		Id:        fmt.Sprintf("%d", result.Database.Id),
-		CreatedAt: result.Database.CreatedAt,
-	}, nil
-}
-
-func (c *Client) DeleteDatabase(projectId, branchId, databaseName string) error {
-	_, err := c.doRequest("DELETE", fmt.Sprintf("/projects/%s/branches/%s/databases/%s", projectId, branchId, databaseName), nil)
-	return err
-}
-
-func (c *Client) CreateRole(projectId, branchId, name string) (*RoleState, error) {
-	body := struct {
-		Role struct {
-			Name string `json:"name"`
-		} `json:"role"`
-	}{
-		Role: struct {
-			Name string `json:"name"`
-		}{
-			Name: name,
+func (c *Client) DeleteEndpoint(ctx context.Context, projectId, endpointId string) error {
+	return c.doOperation(ctx, http.MethodDelete, fmt.Sprintf("/projects/%s/endpoints/%s", projectId, endpointId), projectId, nil, nil)
+}
+
+func (c *Client) CreateDatabase(ctx context.Context, args DatabaseArgs) (*DatabaseState, error) {
+	projectId, branchId := args.ProjectId, args.BranchId
+	log.Printf("Creating database: projectId=%s, branchId=%s, name=%s", projectId, branchId, args.Name)
+	body := map[string]interface{}{
+		"database": map[string]string{
+			"name":       args.Name,
+			"owner_name": args.OwnerName,
 		},
 	}
 
-	resp, err := c.doRequest("POST", fmt.Sprintf("/projects/%s/branches/%s/roles", projectId, branchId), body)
-	if err != nil {
+	var result struct {
+		Database apiDatabase `json:"database"`
+	}
+	err := c.doOperation(ctx, http.MethodPost, fmt.Sprintf("/projects/%s/branches/%s/databases", projectId, branchId), projectId, body, &result)
+	if err != nil && !IsOperationFailed(err) {
+		log.Printf("Error creating database: %v", err)
 		return nil, err
 	}
 
+	log.Printf("Database created: id=%d", result.Database.Id)
+	return result.Database.state(projectId), err
+}
+
+func (c *Client) GetDatabase(ctx context.Context, projectId, branchId, databaseName string) (*DatabaseState, error) {
 	var result struct {
-		Role struct {
-			Name      string `json:"name"`
-			Password  string `json:"password"`
-			Protected bool   `json:"protected"`
-			CreatedAt string `json:"created_at"`
-		} `json:"role"`
+		Database apiDatabase `json:"database"`
 	}
-
-	err = json.Unmarshal(resp, &result)
-	if err != nil {
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/branches/%s/databases/%s", projectId, branchId, databaseName), nil, &result); err != nil {
 		return nil, err
 	}
-
-	return &RoleState{
-		RoleArgs: RoleArgs{
-			ProjectId: projectId,
-			BranchId:  branchId,
-			Name:      result.Role.Name,
-		},
-		Id:        result.Role.Name,
-		CreatedAt: result.Role.CreatedAt,
-	}, nil
+	return result.Database.state(projectId), nil
 }
 
-func (c *Client) GetRole(projectId, branchId, roleName string) (*RoleState, error) {
-	resp, err := c.doRequest("GET", fmt.Sprintf("/projects/%s/branches/%s/roles/%s", projectId, branchId, roleName), nil)
-	if err != nil {
+// GetDatabaseById finds a database on a branch by its numeric ID, which unlike its name
+// does not change when it is renamed. Database IDs are not part of the API path, so this
+// lists the branch's databases.
+func (c *Client) GetDatabaseById(ctx context.Context, projectId, branchId, databaseId string) (*DatabaseState, error) {
+	var result struct {
+		Databases []apiDatabase `json:"databases"`
+	}
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/branches/%s/databases", projectId, branchId), nil, &result); err != nil {
 		return nil, err
 	}
+	for _, database := range result.Databases {
+		if fmt.Sprintf("%d", database.Id) == databaseId {
+			return database.state(projectId), nil
+		}
+	}
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("database %s not found on branch %s", databaseId, branchId),
+	}
+}
 
-	var result struct {
-		Role struct {
-			Name      string `json:"name"`
-			Protected bool   `json:"protected"`
-			CreatedAt string `json:"created_at"`
-		} `json:"role"`
+// UpdateDatabase renames the database called databaseName and sets its owner.
+func (c *Client) UpdateDatabase(ctx context.Context, projectId, branchId, databaseName string, args DatabaseArgs) (*DatabaseState, error) {
+	body := map[string]interface{}{
+		"database": map[string]string{
+			"name":       args.Name,
+			"owner_name": args.OwnerName,
+		},
 	}
 
-	err = json.Unmarshal(resp, &result)
-	if err != nil {
+	var result struct {
+		Database apiDatabase `json:"database"`
+	}
+	if err := c.doOperation(ctx, http.MethodPatch, fmt.Sprintf("/projects/%s/branches/%s/databases/%s", projectId, branchId, databaseName), projectId, body, &result); err != nil {
 		return nil, err
 	}
+	return result.Database.state(projectId), nil
+}
 
-	return &RoleState{
-		RoleArgs: RoleArgs{
-			ProjectId: projectId,
-			BranchId:  branchId,
-			Name:      result.Role.Name,
-		},
-		Id:        result.Role.Name,
-		CreatedAt: result.Role.CreatedAt,
-	}, nil
-}
-
-func (c *Client) UpdateRole(projectId, branchId, roleName, newName string) (*RoleState, error) {
-	body := struct {
-		Role struct {
-			Name string `json:"name"`
-		} `json:"role"`
-	}{
-		Role: struct {
-			Name string `json:"name"`
-		}{
-			Name: newName,
+func (c *Client) DeleteDatabase(ctx context.Context, projectId, branchId, databaseName string) error {
+	return c.doOperation(ctx, http.MethodDelete, fmt.Sprintf("/projects/%s/branches/%s/databases/%s", projectId, branchId, databaseName), projectId, nil, nil)
+}
+
+func (c *Client) CreateRole(ctx context.Context, projectId, branchId, name string) (*RoleState, error) {
+	body := map[string]interface{}{
+		"role": map[string]string{
+			"name": name,
 		},
 	}
 
-	resp, err := c.doRequest("PATCH", fmt.Sprintf("/projects/%s/branches/%s/roles/%s", projectId, branchId, roleName), body)
-	if err != nil {
+	var result struct {
+		Role apiRole `json:"role"`
+	}
+	err := c.doOperation(ctx, http.MethodPost, fmt.Sprintf("/projects/%s/branches/%s/roles", projectId, branchId), projectId, body, &result)
+	if err != nil && !IsOperationFailed(err) {
 		return nil, err
 	}
+	return result.Role.state(projectId, branchId), err
+}
 
+func (c *Client) GetRole(ctx context.Context, projectId, branchId, roleName string) (*RoleState, error) {
 	var result struct {
-		Role struct {
-			Name      string `json:"name"`
-			Protected bool   `json:"protected"`
-			CreatedAt string `json:"created_at"`
-		} `json:"role"`
+		Role apiRole `json:"role"`
 	}
-
-	err = json.Unmarshal(resp, &result)
-	if err != nil {
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/branches/%s/roles/%s", projectId, branchId, roleName), nil, &result); err != nil {
 		return nil, err
 	}
+	return result.Role.state(projectId, branchId), nil
+}
 
-	return &RoleState{
-		RoleArgs: RoleArgs{
-			ProjectId: projectId,
-			BranchId:  branchId,
-			Name:      result.Role.Name,
-		},
-		Id:        result.Role.Name,
-		CreatedAt: result.Role.CreatedAt,
-	}, nil
+func (c *Client) DeleteRole(ctx context.Context, projectId, branchId, roleName string) error {
+	return c.doOperation(ctx, http.MethodDelete, fmt.Sprintf("/projects/%s/branches/%s/roles/%s", projectId, branchId, roleName), projectId, nil, nil)
 }
 
-func (c *Client) DeleteRole(projectId, branchId, roleName string) error {
-	_, err := c.doRequest("DELETE", fmt.Sprintf("/projects/%s/branches/%s/roles/%s", projectId, branchId, roleName), nil)
-	return err
+// RevealRolePassword returns a role's stored password. It fails for projects that do not
+// store passwords.
+func (c *Client) RevealRolePassword(ctx context.Context, projectId, branchId, roleName string) (string, error) {
+	var result struct {
+		Password string `json:"password"`
+	}
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/branches/%s/roles/%s/reveal_password", projectId, branchId, roleName), nil, &result); err != nil {
+		return "", err
+	}
+	return result.Password, nil
 }
 
-// Comment out the IsNotFoundError function
-/*
-func IsNotFoundError(err error) bool {
-	return strings.Contains(err.Error(), "404 Not Found")
+// ResetRolePassword replaces a role's password with a new generated one and returns it.
+// The password is also returned with an *OperationError, since the reset has happened.
+func (c *Client) ResetRolePassword(ctx context.Context, projectId, branchId, roleName string) (string, error) {
+	var result struct {
+		Role apiRole `json:"role"`
+	}
+	err := c.doOperation(ctx, http.MethodPost, fmt.Sprintf("/projects/%s/branches/%s/roles/%s/reset_password", projectId, branchId, roleName), projectId, nil, &result)
+	if err != nil && !IsOperationFailed(err) {
+		return "", err
+	}
+	return result.Role.Password, err
 }
-*/
@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientSendsHeaders(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/p-1", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		assert.Equal(t, "pulumi-neon/test", r.Header.Get("User-Agent"))
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"project": map[string]interface{}{"id": "p-1", "name": "one"},
+		})
+	}))
+	defer api.Close()
+
+	client := NewClient("secret", WithBaseURL(api.URL+"/"), WithUserAgent("pulumi-neon/test"))
+	project, err := client.GetProject(context.Background(), "p-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "p-1", project.ProjectId)
+	assert.Equal(t, "one", project.Name)
+}
+
+func TestClientDecodesErrors(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{
+			"code":    "",
+			"message": "project not found",
+		})
+	}))
+	defer api.Close()
+
+	client := NewClient("secret", WithBaseURL(api.URL))
+	_, err := client.GetProject(context.Background(), "missing")
+
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+	assert.Contains(t, err.Error(), "project not found")
+}
+
+// newRetryClient returns a client for api with backoff shrunk so tests run quickly.
+func newRetryClient(api *httptest.Server, opts ...ClientOption) *Client {
+	client := NewClient("secret", append([]ClientOption{WithBaseURL(api.URL)}, opts...)...)
+	client.minBackoff = time.Millisecond
+	client.maxBackoff = 5 * time.Millisecond
+	return client
+}
+
+func TestClientRetriesLockedAndRateLimited(t *testing.T) {
+	var calls int32
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			writeJSON(w, http.StatusLocked, map[string]interface{}{"message": "project already has running operations"})
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			writeJSON(w, http.StatusTooManyRequests, map[string]interface{}{"message": "rate limit exceeded"})
+		default:
+			writeJSON(w, http.StatusCreated, map[string]interface{}{
+				"project": map[string]interface{}{"id": "p-1"},
+			})
+		}
+	}))
+	defer api.Close()
+
+	project, err := newRetryClient(api).CreateProject(context.Background(), ProjectArgs{Name: "one", RegionId: "aws-us-east-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "p-1", project.ProjectId)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestClientDoesNotRetryServerErrorOnCreate(t *testing.T) {
+	var calls int32
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		writeJSON(w, http.StatusInternalServerError, map[string]interface{}{"message": "boom"})
+	}))
+	defer api.Close()
+
+	_, err := newRetryClient(api).CreateProject(context.Background(), ProjectArgs{Name: "one", RegionId: "aws-us-east-1"})
+
+	require.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestClientRetriesServerErrorOnRead(t *testing.T) {
+	var calls int32
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]interface{}{"message": "unavailable"})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"project": map[string]interface{}{"id": "p-1"},
+		})
+	}))
+	defer api.Close()
+
+	_, err := newRetryClient(api).GetProject(context.Background(), "p-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestClientStopsAtRetryLimits(t *testing.T) {
+	var calls int32
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		writeJSON(w, http.StatusLocked, map[string]interface{}{"message": "locked"})
+	}))
+	defer api.Close()
+
+	_, err := newRetryClient(api, WithMaxRetries(2)).GetProject(context.Background(), "p-1")
+	require.Error(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+
+	// A Retry-After beyond the deadline fails immediately rather than sleeping.
+	api.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		writeJSON(w, http.StatusTooManyRequests, map[string]interface{}{"message": "slow down"})
+	})
+	_, err = newRetryClient(api, WithRetryTimeout(time.Second)).GetProject(context.Background(), "p-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "giving up after 1 attempts")
+}
+
+func TestListProjectsPaginatesOrganizationProjects(t *testing.T) {
+	var cursors []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects", r.URL.Path)
+		assert.Equal(t, "org-acme-1234", r.URL.Query().Get("org_id"))
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+
+		projects := []map[string]interface{}{}
+		next := "p-399"
+		if cursor == "" {
+			for i := 0; i < projectsPageSize; i++ {
+				projects = append(projects, map[string]interface{}{"id": fmt.Sprintf("p-%d", i), "org_id": "org-acme-1234"})
+			}
+		} else {
+			projects = append(projects, map[string]interface{}{"id": "p-400", "org_id": "org-acme-1234"})
+			next = "p-400"
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"projects":   projects,
+			"pagination": map[string]interface{}{"cursor": next},
+		})
+	}))
+	defer api.Close()
+
+	projects, err := newRetryClient(api, WithOrgID("org-acme-1234")).ListProjects(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Len(t, projects, projectsPageSize+1)
+	assert.Equal(t, "org-acme-1234", *projects[0].OrgId)
+	assert.Equal(t, []string{"", "p-399"}, cursors)
+}
+
+func TestClientWaitsForOperations(t *testing.T) {
+	var polls int32
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/projects/p-1/endpoints":
+			writeJSON(w, http.StatusCreated, map[string]interface{}{
+				"endpoint": map[string]interface{}{"id": "ep-1", "project_id": "p-1"},
+				"operations": []map[string]interface{}{
+					{"id": "op-1", "project_id": "p-1", "action": "start_compute", "status": "running"},
+				},
+			})
+		case "/projects/p-1/operations/op-1":
+			status := "running"
+			if atomic.AddInt32(&polls, 1) >= 2 {
+				status = "finished"
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"operation": map[string]interface{}{"id": "op-1", "action": "start_compute", "status": status},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer api.Close()
+
+	client := newRetryClient(api)
+	client.pollInterval = time.Millisecond
+	endpoint, err := client.CreateEndpoint(context.Background(), EndpointArgs{ProjectId: "p-1", BranchId: "br-1", Type: "read_write"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ep-1", endpoint.EndpointId)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&polls))
+}
+
+func TestCreateProjectPollsOperationsOfNewProject(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/projects":
+			// The operation does not name its project, so polling relies on the new
+			// project's ID.
+			writeJSON(w, http.StatusCreated, map[string]interface{}{
+				"project":    map[string]interface{}{"id": "p-new", "name": "app"},
+				"operations": []map[string]interface{}{{"id": "op-1", "action": "create_timeline", "status": "running"}},
+			})
+		case "/projects/p-new/operations/op-1":
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"operation": map[string]interface{}{"id": "op-1", "action": "create_timeline", "status": "finished"},
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer api.Close()
+
+	client := newRetryClient(api)
+	client.pollInterval = time.Millisecond
+	project, err := client.CreateProject(context.Background(), ProjectArgs{Name: "app"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "p-new", project.ProjectId)
+}
+
+func TestClientReportsFailedOperations(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusCreated, map[string]interface{}{
+			"database": map[string]interface{}{"id": 1, "name": "app"},
+			"operations": []map[string]interface{}{
+				{"id": "op-1", "project_id": "p-1", "action": "apply_config", "status": "failed", "error": "disk full"},
+			},
+		})
+	}))
+	defer api.Close()
+
+	_, err := newRetryClient(api).CreateDatabase(context.Background(), DatabaseArgs{ProjectId: "p-1", BranchId: "br-1", Name: "app", OwnerName: "neondb_owner"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "disk full")
+}
+
+func TestAPIErrorClassification(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Neon-Request-Id", "req-123")
+		writeJSON(w, http.StatusConflict, map[string]interface{}{
+			"code":    "BRANCHES_LIMIT_EXCEEDED",
+			"message": "too many branches",
+		})
+	}))
+	defer api.Close()
+
+	_, err := newRetryClient(api).UpdateBranch(context.Background(), "p-1", "br-1", "dev", nil)
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusConflict, apiErr.StatusCode)
+	assert.Equal(t, "BRANCHES_LIMIT_EXCEEDED", apiErr.Code)
+	assert.Equal(t, "too many branches", apiErr.Message)
+	assert.Equal(t, "req-123", apiErr.RequestId)
+	assert.True(t, IsConflict(err))
+	assert.False(t, IsNotFound(err))
+	assert.False(t, IsLocked(err))
+	assert.False(t, IsRateLimited(err))
+
+	wrapped := fmt.Errorf("giving up: %w", &APIError{StatusCode: http.StatusTooManyRequests})
+	assert.True(t, IsRateLimited(wrapped))
+	assert.True(t, IsLocked(&APIError{StatusCode: http.StatusLocked}))
+}
+
+func TestCreateBranchConflictSuggestsImport(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			writeJSON(w, http.StatusConflict, map[string]interface{}{"message": "branch already exists"})
+			return
+		}
+		assert.Equal(t, "/projects/p-1/branches", r.URL.Path)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"branches": []map[string]interface{}{
+				{"id": "br-main", "name": "main", "project_id": "p-1"},
+				{"id": "br-dev", "name": "dev", "project_id": "p-1"},
+			},
+		})
+	}))
+	defer api.Close()
+
+	branch, err := newRetryClient(api).CreateBranch(context.Background(), BranchArgs{ProjectId: "p-1", Name: "dev"})
+
+	assert.Nil(t, branch)
+	assert.True(t, IsConflict(err))
+	assert.ErrorContains(t, err, "pulumi import neon:index:Branch <resourceName> p-1/br-dev")
+}
@@ -0,0 +1,146 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// pulumi-gen-neon writes the provider's schema, or an SDK generated from it, to a
+// directory. The schema comes straight from Provider(), so the SDKs describe exactly the
+// resources the provider serves.
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/blang/semver"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	dotnetgen "github.com/pulumi/pulumi/pkg/v3/codegen/dotnet"
+	gogen "github.com/pulumi/pulumi/pkg/v3/codegen/go"
+	nodejsgen "github.com/pulumi/pulumi/pkg/v3/codegen/nodejs"
+	pythongen "github.com/pulumi/pulumi/pkg/v3/codegen/python"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+
+	neon "github.com/DonsWayo/pulumi-neon/provider"
+)
+
+const tool = "pulumi-gen-neon"
+
+// languages are the SDKs generated into sdk/, named after their directories.
+var languages = []string{"dotnet", "go", "nodejs", "python"}
+
+// logo is the package icon of the .NET SDK. The .NET generator downloads the icon from the
+// package's logoUrl, falling back to a generic Pulumi logo, so this copy is served to it
+// from a local server to keep generation working offline.
+//
+//go:embed logo.png
+var logo []byte
+
+func serveLogo(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "image/png")
+	_, _ = w.Write(logo)
+}
+
+func main() {
+	if len(os.Args) != 3 {
+		fmt.Fprintf(os.Stderr, "usage: %s <schema|dotnet|go|nodejs|python> <output directory>\n", tool)
+		os.Exit(2)
+	}
+	language, outDir := os.Args[1], os.Args[2]
+
+	files, err := generate(language)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", tool, err)
+		os.Exit(1)
+	}
+	if err := write(outDir, files); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", tool, err)
+		os.Exit(1)
+	}
+}
+
+// providerSchema returns the schema the provider serves. It is generated without a
+// version so that the SDKs only change when the provider's resources do; releases stamp
+// the version into the packages when they are built.
+func providerSchema() (schema.PackageSpec, error) {
+	server := integration.NewServer(neon.Name, semver.Version{}, neon.Provider())
+	resp, err := server.GetSchema(p.GetSchemaRequest{})
+	if err != nil {
+		return schema.PackageSpec{}, fmt.Errorf("failed to get the provider's schema: %w", err)
+	}
+
+	var spec schema.PackageSpec
+	if err := json.Unmarshal([]byte(resp.Schema), &spec); err != nil {
+		return schema.PackageSpec{}, fmt.Errorf("failed to decode the provider's schema: %w", err)
+	}
+	spec.Version = ""
+	return spec, nil
+}
+
+// generate returns the files of the SDK for language, keyed by their path within the
+// SDK's directory. The "schema" language returns the schema itself.
+func generate(language string) (map[string][]byte, error) {
+	spec, err := providerSchema()
+	if err != nil {
+		return nil, err
+	}
+	if language == "schema" {
+		data, err := json.MarshalIndent(spec, "", "    ")
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{"schema.json": append(data, '\n')}, nil
+	}
+
+	pkg, err := schema.ImportSpec(spec, map[string]schema.Language{
+		"csharp": dotnetgen.Importer,
+		"go":     gogen.Importer,
+		"nodejs": nodejsgen.Importer,
+		"python": pythongen.Importer,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %v", err)
+	}
+
+	switch language {
+	case "dotnet":
+		logoServer := httptest.NewServer(http.HandlerFunc(serveLogo))
+		defer logoServer.Close()
+		pkg.LogoURL = logoServer.URL + "/logo.png"
+		return dotnetgen.GeneratePackage(tool, pkg, nil, nil)
+	case "go":
+		return gogen.GeneratePackage(tool, pkg, nil)
+	case "nodejs":
+		return nodejsgen.GeneratePackage(tool, pkg, nil, nil, false)
+	case "python":
+		return pythongen.GeneratePackage(tool, pkg, nil)
+	}
+	return nil, fmt.Errorf("unknown language %q", language)
+}
+
+func write(outDir string, files map[string][]byte) error {
+	for path, data := range files {
+		path = filepath.Join(outDir, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sdkDir is the checked-in sdk/ directory, relative to this package.
+const sdkDir = "../../../sdk"
+
+// generatedMarker is written near the top of every file the generators emit that can
+// carry a comment.
+var generatedMarker = []byte("Do not edit by hand unless you're certain you know what you are doing!")
+
+// TestSDKsAreUpToDate fails when the SDKs under sdk/ no longer match the provider's
+// schema. Run `make build` (or pulumi-gen-neon for a single language) to regenerate them.
+func TestSDKsAreUpToDate(t *testing.T) {
+	for _, language := range languages {
+		language := language
+		t.Run(language, func(t *testing.T) {
+			files, err := generate(language)
+			require.NoError(t, err)
+
+			dir := filepath.Join(sdkDir, language)
+			for path, want := range files {
+				got, err := os.ReadFile(filepath.Join(dir, path))
+				if !assert.NoError(t, err, "sdk/%s/%s is missing", language, path) {
+					continue
+				}
+				assert.True(t, bytes.Equal(want, got), "sdk/%s/%s is out of date", language, path)
+			}
+
+			// Files that were generated once but no longer are, such as the SDK of a
+			// removed resource, must be deleted too.
+			err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				if d.IsDir() {
+					switch d.Name() {
+					case "bin", "obj", "node_modules":
+						return filepath.SkipDir
+					}
+					return nil
+				}
+				rel, err := filepath.Rel(dir, path)
+				if err != nil {
+					return err
+				}
+				if _, ok := files[filepath.ToSlash(rel)]; ok {
+					return nil
+				}
+				data, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				assert.False(t, bytes.Contains(data, generatedMarker),
+					"sdk/%s/%s is no longer generated", language, rel)
+				return nil
+			})
+			require.NoError(t, err)
+		})
+	}
+}
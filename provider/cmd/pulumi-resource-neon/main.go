@@ -15,10 +15,8 @@
 package main
 
 import (
-	p "github.com/pulumi/pulumi-go-provider"
-
 	neon "github.com/DonsWayo/pulumi-neon/provider"
 )
 
 // Serve the provider against Pulumi's Provider protocol.
-func main() { p.RunProvider(neon.Name, neon.Version, neon.Provider()) }
+func main() { neon.Serve() }
@@ -1,267 +1,167 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 )
 
 type Database struct{}
 
+func (d *Database) Annotate(a infer.Annotator) {
+	a.Describe(&d, "A Postgres database on a Neon branch. Import it with an ID of the form "+databaseIDFormat+
+		", where the database's name may be given in place of its ID.")
+}
+
 type DatabaseArgs struct {
 	ProjectId string `pulumi:"projectId"`
 	BranchId  string `pulumi:"branchId"`
 	Name      string `pulumi:"name"`
+	// OwnerName is the role that owns the database, typically the name of a Role resource.
+	OwnerName string `pulumi:"ownerName"`
+}
+
+func (args *DatabaseArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.ProjectId, "The ID of the project the database belongs to. Changing it replaces the database.")
+	a.Describe(&args.BranchId, "The ID of the branch the database is on. Changing it replaces the database.")
+	a.Describe(&args.Name, "The name of the database.")
+	a.Describe(&args.OwnerName, "The role that owns the database, typically the name of a Role resource.")
 }
 
 type DatabaseState struct {
 	DatabaseArgs
-	Id        string `pulumi:"id"`
-	CreatedAt string `pulumi:"createdAt"`
+	DatabaseId string `pulumi:"databaseId"`
+	CreatedAt  string `pulumi:"createdAt"`
 }
 
-func (d Database) Create(ctx context.Context, name string, input DatabaseArgs, preview bool) (string, DatabaseState, error) {
-	if preview {
-		return name, DatabaseState{DatabaseArgs: input}, nil
-	}
-
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return "", DatabaseState{}, fmt.Errorf("missing configuration")
-	}
-
-	databaseData := struct {
-		Name      string `json:"name"`
-		OwnerName string `json:"owner_name"`
-	}{
-		Name:      input.Name,
-		OwnerName: "default",
-	}
+func (s *DatabaseState) Annotate(a infer.Annotator) {
+	a.Describe(&s.DatabaseId, "The ID Neon assigned to the database.")
+	a.Describe(&s.CreatedAt, "When the database was created, in RFC 3339 format.")
+}
 
-	jsonData, err := json.Marshal(map[string]interface{}{"database": databaseData})
-	if err != nil {
-		return "", DatabaseState{}, fmt.Errorf("failed to marshal database data: %v", err)
-	}
+// WireDependencies keeps the database's ID known when previewing an update, which at most
+// renames it.
+func (d Database) WireDependencies(f infer.FieldSelector, args *DatabaseArgs, state *DatabaseState) {
+	f.OutputField(&state.Name).DependsOn(f.InputField(&args.Name))
+}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/projects/%s/branches/%s/databases", baseURL, input.ProjectId, input.BranchId), bytes.NewBuffer(jsonData))
-	if err != nil {
-		return "", DatabaseState{}, fmt.Errorf("failed to create request: %v", err)
+// Check validates the database's inputs before anything is sent to Neon.
+func (d Database) Check(ctx context.Context, name string, oldInputs, newInputs resource.PropertyMap) (DatabaseArgs, []p.CheckFailure, error) {
+	args, failures, err := infer.DefaultCheck[DatabaseArgs](ctx, newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
+	c := newChecker(newInputs)
+	c.check("name", validIdentifier)
+	c.check("ownerName", validIdentifier)
+	return args, c.failures, nil
+}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", DatabaseState{}, fmt.Errorf("failed to send request: %v", err)
+func (d Database) Create(ctx context.Context, name string, input DatabaseArgs, preview bool) (string, DatabaseState, error) {
+	if preview {
+		return "", DatabaseState{DatabaseArgs: input}, nil
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	client, err := getClient(ctx)
 	if err != nil {
-		return "", DatabaseState{}, fmt.Errorf("failed to read response body: %v", err)
-	}
-
-	if resp.StatusCode != http.StatusCreated {
-		return "", DatabaseState{}, fmt.Errorf("failed to create database: %s", string(body))
+		return "", DatabaseState{}, err
 	}
 
-	var result struct {
-		Database struct {
-			Id        int64  `json:"id"`
-			Name      string `json:"name"`
-			OwnerName string `json:"owner_name"`
-			ProjectId string `json:"project_id"`
-			BranchId  string `json:"branch_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"database"`
+	database, err := client.CreateDatabase(ctx, input)
+	if IsOperationFailed(err) {
+		return resourceID(database.ProjectId, database.BranchId, database.DatabaseId), *database, initFailed(err)
 	}
-
-	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return "", DatabaseState{}, fmt.Errorf("failed to unmarshal response: %v", err)
+		return "", DatabaseState{}, fmt.Errorf("failed to create database: %w", err)
 	}
 
-	return name, DatabaseState{
-		DatabaseArgs: DatabaseArgs{
-			ProjectId: result.Database.ProjectId,
-			BranchId:  result.Database.BranchId,
-			Name:      result.Database.Name,
-		},
-		Id:        fmt.Sprintf("%d", result.Database.Id),
-		CreatedAt: result.Database.CreatedAt,
-	}, nil
+	return resourceID(database.ProjectId, database.BranchId, database.DatabaseId), *database, nil
 }
 
 func (d Database) Read(ctx context.Context, id string, inputs DatabaseArgs, state DatabaseState) (string, DatabaseArgs, DatabaseState, error) {
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return "", DatabaseArgs{}, DatabaseState{}, fmt.Errorf("missing configuration")
-	}
-
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/projects/%s/branches/%s/databases/%s", baseURL, state.ProjectId, state.BranchId, state.Name), nil)
+	client, err := getClient(ctx)
 	if err != nil {
-		return "", DatabaseArgs{}, DatabaseState{}, fmt.Errorf("failed to create request: %v", err)
+		return "", DatabaseArgs{}, DatabaseState{}, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", DatabaseArgs{}, DatabaseState{}, fmt.Errorf("failed to send request: %v", err)
+	projectId, branchId := state.ProjectId, state.BranchId
+	databaseName, databaseId := state.Name, state.DatabaseId
+	if projectId == "" || branchId == "" || databaseName == "" {
+		// An import gives either the database's ID or its name, so the last part of the
+		// ID is tried as both.
+		parts, err := parseResourceID(id, databaseIDFormat)
+		if err != nil {
+			return "", DatabaseArgs{}, DatabaseState{}, err
+		}
+		projectId, branchId = parts[0], parts[1]
+		databaseName, databaseId = parts[2], parts[2]
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", DatabaseArgs{}, DatabaseState{}, fmt.Errorf("failed to read response body: %v", err)
+	database, err := client.GetDatabase(ctx, projectId, branchId, databaseName)
+	if IsNotFound(err) && databaseId != "" {
+		// The database may have been renamed outside of Pulumi, so look for it by the
+		// numeric ID Neon keeps across renames.
+		database, err = client.GetDatabaseById(ctx, projectId, branchId, databaseId)
 	}
-
-	if resp.StatusCode != http.StatusOK {
-		if IsNotFoundError(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))) {
+	if err != nil {
+		if IsNotFound(err) {
 			return "", DatabaseArgs{}, DatabaseState{}, nil
 		}
-		return "", DatabaseArgs{}, DatabaseState{}, fmt.Errorf("failed to read database: %s", string(body))
+		return "", DatabaseArgs{}, DatabaseState{}, fmt.Errorf("failed to read database: %w", err)
 	}
 
-	var result struct {
-		Database struct {
-			Id        int64  `json:"id"`
-			Name      string `json:"name"`
-			OwnerName string `json:"owner_name"`
-			ProjectId string `json:"project_id"`
-			BranchId  string `json:"branch_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"database"`
-	}
+	// IDs of databases created before they were keyed by their numeric ID hold the name
+	// instead, and are rewritten here.
+	return resourceID(projectId, branchId, database.DatabaseId), database.DatabaseArgs, *database, nil
+}
 
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return "", DatabaseArgs{}, DatabaseState{}, fmt.Errorf("failed to unmarshal response: %v", err)
-	}
+// Diff compares the program's inputs with the last known state of the database. The name
+// and owner are patched in place, while moving the database to another branch replaces it.
+func (d Database) Diff(ctx context.Context, id string, olds DatabaseState, news DatabaseArgs) (p.DiffResponse, error) {
+	diff := diffBuilder{}
+	diff.replace("projectId", olds.ProjectId != news.ProjectId)
+	diff.replace("branchId", olds.BranchId != news.BranchId)
+	diff.update("name", olds.Name != news.Name)
+	diff.update("ownerName", olds.OwnerName != news.OwnerName)
 
-	return id, DatabaseArgs{
-		ProjectId: result.Database.ProjectId,
-		BranchId:  result.Database.BranchId,
-		Name:      result.Database.Name,
-	}, DatabaseState{
-		DatabaseArgs: DatabaseArgs{
-			ProjectId: result.Database.ProjectId,
-			BranchId:  result.Database.BranchId,
-			Name:      result.Database.Name,
-		},
-		Id:        fmt.Sprintf("%d", result.Database.Id),
-		CreatedAt: result.Database.CreatedAt,
-	}, nil
+	return diff.response(), nil
 }
 
 func (d Database) Update(ctx context.Context, id string, olds DatabaseState, news DatabaseArgs, preview bool) (DatabaseState, error) {
 	if preview {
 		return DatabaseState{
 			DatabaseArgs: news,
-			Id:           olds.Id,
+			DatabaseId:   olds.DatabaseId,
 			CreatedAt:    olds.CreatedAt,
 		}, nil
 	}
 
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return DatabaseState{}, fmt.Errorf("missing configuration")
-	}
-
-	databaseData := struct {
-		Name string `json:"name"`
-	}{
-		Name: news.Name,
-	}
-
-	jsonData, err := json.Marshal(map[string]interface{}{"database": databaseData})
-	if err != nil {
-		return DatabaseState{}, fmt.Errorf("failed to marshal database data: %v", err)
-	}
-
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/projects/%s/branches/%s/databases/%s", baseURL, news.ProjectId, news.BranchId, olds.Name), bytes.NewBuffer(jsonData))
-	if err != nil {
-		return DatabaseState{}, fmt.Errorf("failed to create request: %v", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return DatabaseState{}, fmt.Errorf("failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+	client, err := getClient(ctx)
 	if err != nil {
-		return DatabaseState{}, fmt.Errorf("failed to read response body: %v", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return DatabaseState{}, fmt.Errorf("failed to update database: %s", string(body))
-	}
-
-	var result struct {
-		Database struct {
-			Id        int64  `json:"id"`
-			Name      string `json:"name"`
-			OwnerName string `json:"owner_name"`
-			ProjectId string `json:"project_id"`
-			BranchId  string `json:"branch_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"database"`
+		return DatabaseState{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	database, err := client.UpdateDatabase(ctx, news.ProjectId, news.BranchId, olds.Name, news)
 	if err != nil {
-		return DatabaseState{}, fmt.Errorf("failed to unmarshal response: %v", err)
+		return DatabaseState{}, fmt.Errorf("failed to update database: %w", err)
 	}
 
-	return DatabaseState{
-		DatabaseArgs: DatabaseArgs{
-			ProjectId: result.Database.ProjectId,
-			BranchId:  result.Database.BranchId,
-			Name:      result.Database.Name,
-		},
-		Id:        fmt.Sprintf("%d", result.Database.Id),
-		CreatedAt: result.Database.CreatedAt,
-	}, nil
+	return *database, nil
 }
 
 func (d Database) Delete(ctx context.Context, id string, state DatabaseState) error {
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return fmt.Errorf("missing configuration")
-	}
-
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/projects/%s/branches/%s/databases/%s", baseURL, state.ProjectId, state.BranchId, state.Name), nil)
+	client, err := getClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %v", err)
+		return err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete database: %s", string(body))
+	if err := client.DeleteDatabase(ctx, state.ProjectId, state.BranchId, state.Name); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete database: %w", err)
 	}
 
 	return nil
-}
\ No newline at end of file
+}
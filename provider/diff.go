@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// diffBuilder collects the properties that changed between a resource's state and the
+// program's inputs, keyed by property path.
+type diffBuilder map[string]p.PropertyDiff
+
+// update records key as changed in place when changed is true.
+func (d diffBuilder) update(key string, changed bool) {
+	if changed {
+		d[key] = p.PropertyDiff{Kind: p.Update}
+	}
+}
+
+// replace records key as changed in a way that requires replacing the resource when
+// changed is true.
+func (d diffBuilder) replace(key string, changed bool) {
+	if changed {
+		d[key] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+}
+
+// replaces reports whether any recorded change requires replacing the resource.
+func (d diffBuilder) replaces() bool {
+	for _, diff := range d {
+		if diff.Kind == p.UpdateReplace {
+			return true
+		}
+	}
+	return false
+}
+
+func (d diffBuilder) response() p.DiffResponse {
+	return p.DiffResponse{
+		HasChanges:   len(d) > 0,
+		DetailedDiff: d,
+	}
+}
+
+// optionalChanged reports whether an optional input has been set to something other than
+// its last known value. Leaving an input unset keeps Neon's value, which is not a change.
+func optionalChanged[T comparable](old, new *T) bool {
+	return new != nil && (old == nil || *old != *new)
+}
+
+// timestampChanged is optionalChanged for RFC 3339 timestamps, which Neon may echo back
+// in a different but equivalent form.
+func timestampChanged(old, new *string) bool {
+	if !optionalChanged(old, new) {
+		return false
+	}
+	if old == nil {
+		return true
+	}
+	oldTime, err := time.Parse(time.RFC3339, *old)
+	if err != nil {
+		return true
+	}
+	newTime, err := time.Parse(time.RFC3339, *new)
+	if err != nil {
+		return true
+	}
+	return !oldTime.Equal(newTime)
+}
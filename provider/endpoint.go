@@ -1,274 +1,274 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
+	"log"
+	"reflect"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 )
 
 type Endpoint struct{}
 
+func (e *Endpoint) Annotate(a infer.Annotator) {
+	a.Describe(&e, "A compute endpoint on a Neon branch. Import it with an ID of the form "+endpointIDFormat+".")
+}
+
+// EndpointArgs configures a compute endpoint. Optional settings that are left unset keep
+// whatever Neon chooses, and the state records the values in effect.
 type EndpointArgs struct {
 	ProjectId string `pulumi:"projectId"`
 	BranchId  string `pulumi:"branchId"`
-	Type      string `pulumi:"type"`
+	// Type is read_write or read_only. Changing it replaces the endpoint.
+	Type                  string   `pulumi:"type"`
+	AutoscalingLimitMinCu *float64 `pulumi:"autoscalingLimitMinCu,optional"`
+	AutoscalingLimitMaxCu *float64 `pulumi:"autoscalingLimitMaxCu,optional"`
+	SuspendTimeoutSeconds *int     `pulumi:"suspendTimeoutSeconds,optional"`
+	PoolerEnabled         *bool    `pulumi:"poolerEnabled,optional"`
+	PoolerMode            *string  `pulumi:"poolerMode,optional"`
+	// RegionId must match the project's region. Changing it replaces the endpoint.
+	RegionId    *string `pulumi:"regionId,optional"`
+	Provisioner *string `pulumi:"provisioner,optional"`
+	// Settings are Postgres settings applied to the endpoint's compute.
+	Settings map[string]string `pulumi:"settings,optional"`
+	// RoleName and DatabaseName choose the credentials and database used for the
+	// connection URI outputs. Both must be set for the URIs to be filled in.
+	RoleName     *string `pulumi:"roleName,optional"`
+	DatabaseName *string `pulumi:"databaseName,optional"`
+}
+
+func (args *EndpointArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.ProjectId, "The ID of the project the endpoint belongs to. Changing it replaces the endpoint.")
+	a.Describe(&args.BranchId, "The ID of the branch the endpoint serves. Changing it moves the endpoint to that branch.")
+	a.Describe(&args.Type, "The endpoint type, read_write or read_only. Changing it replaces the endpoint.")
+	a.Describe(&args.AutoscalingLimitMinCu, "The minimum compute size, in compute units.")
+	a.Describe(&args.AutoscalingLimitMaxCu, "The maximum compute size, in compute units.")
+	a.Describe(&args.SuspendTimeoutSeconds, "How long, in seconds, an idle endpoint keeps running before it is suspended.")
+	a.Describe(&args.PoolerEnabled, "Whether connections can go through the PgBouncer connection pooler.")
+	a.Describe(&args.PoolerMode, "The connection pooler mode, such as transaction.")
+	a.Describe(&args.RegionId, "The region of the endpoint, which must match the project's region. Changing it replaces the endpoint.")
+	a.Describe(&args.Provisioner, "The compute provisioner, k8s-pod or k8s-neonvm.")
+	a.Describe(&args.Settings, "Postgres settings applied to the endpoint's compute.")
+	a.Describe(&args.RoleName, "The role whose credentials the connection URIs use. The URIs are only filled in when databaseName is set too.")
+	a.Describe(&args.DatabaseName, "The database the connection URIs connect to. The URIs are only filled in when roleName is set too.")
 }
 
 type EndpointState struct {
 	EndpointArgs
-	Id        string `pulumi:"id"`
-	Host      string `pulumi:"host"`
-	CreatedAt string `pulumi:"createdAt"`
+	EndpointId string `pulumi:"endpointId"`
+	Host       string `pulumi:"host"`
+	// PoolerHost is the hostname that routes connections through PgBouncer.
+	PoolerHost   string `pulumi:"poolerHost,optional"`
+	Port         int    `pulumi:"port,optional"`
+	ProxyHost    string `pulumi:"proxyHost,optional"`
+	CurrentState string `pulumi:"currentState,optional"`
+	LastActive   string `pulumi:"lastActive,optional"`
+	// ConnectionUri and PooledConnectionUri embed the role's password.
+	ConnectionUri       string `pulumi:"connectionUri,optional" provider:"secret"`
+	PooledConnectionUri string `pulumi:"pooledConnectionUri,optional" provider:"secret"`
+	CreatedAt           string `pulumi:"createdAt"`
 }
 
-func (e Endpoint) Create(ctx context.Context, name string, input EndpointArgs, preview bool) (string, EndpointState, error) {
-	if preview {
-		return name, EndpointState{EndpointArgs: input}, nil
-	}
+func (s *EndpointState) Annotate(a infer.Annotator) {
+	a.Describe(&s.EndpointId, "The ID Neon assigned to the endpoint.")
+	a.Describe(&s.Host, "The hostname of the endpoint.")
+	a.Describe(&s.PoolerHost, "The hostname that routes connections through PgBouncer.")
+	a.Describe(&s.Port, "The port the endpoint accepts connections on.")
+	a.Describe(&s.ProxyHost, "The hostname of the proxy in front of the endpoint.")
+	a.Describe(&s.CurrentState, "The state of the endpoint's compute, such as active or idle.")
+	a.Describe(&s.LastActive, "When the endpoint was last active, in RFC 3339 format.")
+	a.Describe(&s.ConnectionUri, "A connection URI for databaseName as roleName. It embeds the role's password.")
+	a.Describe(&s.PooledConnectionUri, "A connection URI for databaseName as roleName that goes through the connection pooler. It embeds the role's password.")
+	a.Describe(&s.CreatedAt, "When the endpoint was created, in RFC 3339 format.")
+}
 
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return "", EndpointState{}, fmt.Errorf("missing configuration")
-	}
+// WireDependencies marks the connection URIs secret, since they embed a password. An
+// update keeps the endpoint's ID and hosts, so a preview only reports the connection URIs
+// and compute state as unknown when the inputs they come from change.
+func (e Endpoint) WireDependencies(f infer.FieldSelector, args *EndpointArgs, state *EndpointState) {
+	f.OutputField(&state.ConnectionUri).AlwaysSecret()
+	f.OutputField(&state.PooledConnectionUri).AlwaysSecret()
+
+	credentials := []infer.InputField{f.InputField(&args.RoleName), f.InputField(&args.DatabaseName)}
+	f.OutputField(&state.ConnectionUri).DependsOn(credentials...)
+	f.OutputField(&state.PooledConnectionUri).DependsOn(credentials...)
+	f.OutputField(&state.CurrentState).DependsOn(f.InputField(args))
+	f.OutputField(&state.LastActive).DependsOn(f.InputField(args))
+}
 
-	endpointData := struct {
-		BranchId string `json:"branch_id"`
-		Type     string `json:"type"`
-	}{
-		BranchId: input.BranchId,
-		Type:     input.Type,
+// connect fills in the connection URIs for the role and database named in the state's
+// inputs, or clears them when either is missing.
+func (e Endpoint) connect(ctx context.Context, client *Client, state *EndpointState) error {
+	state.ConnectionUri, state.PooledConnectionUri = "", ""
+	if state.RoleName == nil || state.DatabaseName == nil {
+		return nil
 	}
 
-	jsonData, err := json.Marshal(map[string]interface{}{"endpoint": endpointData})
+	uri, err := client.GetConnectionURI(ctx, state.ProjectId, state.BranchId, state.EndpointId, *state.DatabaseName, *state.RoleName, false)
 	if err != nil {
-		return "", EndpointState{}, fmt.Errorf("failed to marshal endpoint data: %v", err)
+		return fmt.Errorf("failed to get connection URI: %w", err)
 	}
-
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/projects/%s/endpoints", baseURL, input.ProjectId), bytes.NewBuffer(jsonData))
+	pooledURI, err := client.GetConnectionURI(ctx, state.ProjectId, state.BranchId, state.EndpointId, *state.DatabaseName, *state.RoleName, true)
 	if err != nil {
-		return "", EndpointState{}, fmt.Errorf("failed to create request: %v", err)
+		return fmt.Errorf("failed to get pooled connection URI: %w", err)
 	}
+	state.ConnectionUri, state.PooledConnectionUri = uri, pooledURI
+	return nil
+}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
+// reconnect is connect for an endpoint that is already in state. A failed lookup is only
+// logged, so that it does not fail refreshes and updates, and the previous URIs are kept
+// while they are for the same role and database.
+func (e Endpoint) reconnect(ctx context.Context, client *Client, state *EndpointState, previous EndpointState) {
+	if err := e.connect(ctx, client, state); err != nil {
+		log.Printf("Could not look up the connection URIs of endpoint %s: %v", state.EndpointId, err)
+		if reflect.DeepEqual(state.RoleName, previous.RoleName) && reflect.DeepEqual(state.DatabaseName, previous.DatabaseName) {
+			state.ConnectionUri, state.PooledConnectionUri = previous.ConnectionUri, previous.PooledConnectionUri
+		}
+	}
+}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", EndpointState{}, fmt.Errorf("failed to send request: %v", err)
+// Check validates the endpoint's inputs before anything is sent to Neon.
+func (e Endpoint) Check(ctx context.Context, name string, oldInputs, newInputs resource.PropertyMap) (EndpointArgs, []p.CheckFailure, error) {
+	args, failures, err := infer.DefaultCheck[EndpointArgs](ctx, newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	c := newChecker(newInputs)
+	c.check("type", validEndpointType)
+	c.check("regionId", validRegionID)
+	c.check("provisioner", validProvisioner)
+	c.check("poolerMode", validPoolerMode)
+	c.check("roleName", validIdentifier)
+	c.check("databaseName", validIdentifier)
+	c.checkComputeLimits(newInputs, "")
+	return args, c.failures, nil
+}
+
+func (e Endpoint) Create(ctx context.Context, name string, input EndpointArgs, preview bool) (string, EndpointState, error) {
+	client, err := getClient(ctx)
 	if err != nil {
-		return "", EndpointState{}, fmt.Errorf("failed to read response body: %v", err)
+		return "", EndpointState{}, err
 	}
-
-	if resp.StatusCode != http.StatusCreated {
-		return "", EndpointState{}, fmt.Errorf("failed to create endpoint: %s", string(body))
+	if preview {
+		if input.RegionId != nil {
+			if err := client.checkRegion(ctx, *input.RegionId); err != nil {
+				return "", EndpointState{}, err
+			}
+		}
+		if err := client.checkComputeLimit(ctx, input.AutoscalingLimitMaxCu); err != nil {
+			return "", EndpointState{}, err
+		}
+		return "", EndpointState{EndpointArgs: input}, nil
 	}
 
-	var result struct {
-		Endpoint struct {
-			Id        string `json:"id"`
-			Host      string `json:"host"`
-			ProjectId string `json:"project_id"`
-			BranchId  string `json:"branch_id"`
-			Type      string `json:"type"`
-			CreatedAt string `json:"created_at"`
-		} `json:"endpoint"`
+	endpoint, err := client.CreateEndpoint(ctx, input)
+	if err != nil && !IsOperationFailed(err) {
+		return "", EndpointState{}, fmt.Errorf("failed to create endpoint: %w", err)
 	}
-
-	err = json.Unmarshal(body, &result)
+	id := resourceID(endpoint.ProjectId, endpoint.EndpointId)
+	endpoint.RoleName, endpoint.DatabaseName = input.RoleName, input.DatabaseName
 	if err != nil {
-		return "", EndpointState{}, fmt.Errorf("failed to unmarshal response: %v", err)
+		return id, *endpoint, initFailed(err)
 	}
 
-	return name, EndpointState{
-		EndpointArgs: EndpointArgs{
-			ProjectId: result.Endpoint.ProjectId,
-			BranchId:  result.Endpoint.BranchId,
-			Type:      result.Endpoint.Type,
-		},
-		Id:        result.Endpoint.Id,
-		Host:      result.Endpoint.Host,
-		CreatedAt: result.Endpoint.CreatedAt,
-	}, nil
+	if err := e.connect(ctx, client, endpoint); err != nil {
+		return id, *endpoint, initFailed(err)
+	}
+
+	return id, *endpoint, nil
 }
 
 func (e Endpoint) Read(ctx context.Context, id string, inputs EndpointArgs, state EndpointState) (string, EndpointArgs, EndpointState, error) {
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return "", EndpointArgs{}, EndpointState{}, fmt.Errorf("missing configuration")
-	}
-
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/projects/%s/endpoints/%s", baseURL, state.ProjectId, state.Id), nil)
+	client, err := getClient(ctx)
 	if err != nil {
-		return "", EndpointArgs{}, EndpointState{}, fmt.Errorf("failed to create request: %v", err)
+		return "", EndpointArgs{}, EndpointState{}, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", EndpointArgs{}, EndpointState{}, fmt.Errorf("failed to send request: %v", err)
+	projectId, endpointId := state.ProjectId, state.EndpointId
+	if projectId == "" || endpointId == "" {
+		parts, err := parseResourceID(id, endpointIDFormat)
+		if err != nil {
+			return "", EndpointArgs{}, EndpointState{}, err
+		}
+		projectId, endpointId = parts[0], parts[1]
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	endpoint, err := client.GetEndpoint(ctx, projectId, endpointId)
 	if err != nil {
-		return "", EndpointArgs{}, EndpointState{}, fmt.Errorf("failed to read response body: %v", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		if IsNotFoundError(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))) {
+		if IsNotFound(err) {
 			return "", EndpointArgs{}, EndpointState{}, nil
 		}
-		return "", EndpointArgs{}, EndpointState{}, fmt.Errorf("failed to read endpoint: %s", string(body))
+		return "", EndpointArgs{}, EndpointState{}, fmt.Errorf("failed to read endpoint: %w", err)
 	}
 
-	var result struct {
-		Endpoint struct {
-			Id        string `json:"id"`
-			Host      string `json:"host"`
-			ProjectId string `json:"project_id"`
-			BranchId  string `json:"branch_id"`
-			Type      string `json:"type"`
-			CreatedAt string `json:"created_at"`
-		} `json:"endpoint"`
-	}
+	// The role and database only exist in the program, so they are carried over.
+	endpoint.RoleName, endpoint.DatabaseName = state.RoleName, state.DatabaseName
+	e.reconnect(ctx, client, endpoint, state)
 
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return "", EndpointArgs{}, EndpointState{}, fmt.Errorf("failed to unmarshal response: %v", err)
-	}
+	return resourceID(endpoint.ProjectId, endpoint.EndpointId), endpoint.EndpointArgs, *endpoint, nil
+}
 
-	return id, EndpointArgs{
-		ProjectId: result.Endpoint.ProjectId,
-		BranchId:  result.Endpoint.BranchId,
-		Type:      result.Endpoint.Type,
-	}, EndpointState{
-		EndpointArgs: EndpointArgs{
-			ProjectId: result.Endpoint.ProjectId,
-			BranchId:  result.Endpoint.BranchId,
-			Type:      result.Endpoint.Type,
-		},
-		Id:        result.Endpoint.Id,
-		Host:      result.Endpoint.Host,
-		CreatedAt: result.Endpoint.CreatedAt,
-	}, nil
+// Diff compares the program's inputs with the last known state of the endpoint. Compute,
+// pooler and Postgres settings are patched in place, and the endpoint can be moved to
+// another branch of the same project.
+func (e Endpoint) Diff(ctx context.Context, id string, olds EndpointState, news EndpointArgs) (p.DiffResponse, error) {
+	diff := diffBuilder{}
+	diff.replace("projectId", olds.ProjectId != news.ProjectId)
+	diff.update("branchId", olds.BranchId != news.BranchId)
+	diff.replace("type", olds.Type != news.Type)
+	diff.update("autoscalingLimitMinCu", optionalChanged(olds.AutoscalingLimitMinCu, news.AutoscalingLimitMinCu))
+	diff.update("autoscalingLimitMaxCu", optionalChanged(olds.AutoscalingLimitMaxCu, news.AutoscalingLimitMaxCu))
+	diff.update("suspendTimeoutSeconds", optionalChanged(olds.SuspendTimeoutSeconds, news.SuspendTimeoutSeconds))
+	diff.update("poolerEnabled", optionalChanged(olds.PoolerEnabled, news.PoolerEnabled))
+	diff.update("poolerMode", optionalChanged(olds.PoolerMode, news.PoolerMode))
+	diff.replace("regionId", optionalChanged(olds.RegionId, news.RegionId))
+	diff.update("provisioner", optionalChanged(olds.Provisioner, news.Provisioner))
+	diff.update("settings", news.Settings != nil && (len(olds.Settings) > 0 || len(news.Settings) > 0) &&
+		!reflect.DeepEqual(olds.Settings, news.Settings))
+	diff.update("roleName", !reflect.DeepEqual(olds.RoleName, news.RoleName))
+	diff.update("databaseName", !reflect.DeepEqual(olds.DatabaseName, news.DatabaseName))
+	return diff.response(), nil
 }
 
 func (e Endpoint) Update(ctx context.Context, id string, olds EndpointState, news EndpointArgs, preview bool) (EndpointState, error) {
-	if preview {
-		return EndpointState{
-			EndpointArgs: news,
-			Id:           olds.Id,
-			Host:         olds.Host,
-			CreatedAt:    olds.CreatedAt,
-		}, nil
-	}
-
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return EndpointState{}, fmt.Errorf("missing configuration")
-	}
-
-	endpointData := struct {
-		BranchId string `json:"branch_id"`
-		Type     string `json:"type"`
-	}{
-		BranchId: news.BranchId,
-		Type:     news.Type,
-	}
-
-	jsonData, err := json.Marshal(map[string]interface{}{"endpoint": endpointData})
-	if err != nil {
-		return EndpointState{}, fmt.Errorf("failed to marshal endpoint data: %v", err)
-	}
-
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/projects/%s/endpoints/%s", baseURL, news.ProjectId, olds.Id), bytes.NewBuffer(jsonData))
+	client, err := getClient(ctx)
 	if err != nil {
-		return EndpointState{}, fmt.Errorf("failed to create request: %v", err)
+		return EndpointState{}, err
 	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return EndpointState{}, fmt.Errorf("failed to send request: %v", err)
+	if preview {
+		if err := client.checkComputeLimit(ctx, news.AutoscalingLimitMaxCu); err != nil {
+			return EndpointState{}, err
+		}
+		state := olds
+		state.EndpointArgs = news
+		return state, nil
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	endpoint, err := client.UpdateEndpoint(ctx, news.ProjectId, olds.EndpointId, news)
 	if err != nil {
-		return EndpointState{}, fmt.Errorf("failed to read response body: %v", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return EndpointState{}, fmt.Errorf("failed to update endpoint: %s", string(body))
-	}
-
-	var result struct {
-		Endpoint struct {
-			Id        string `json:"id"`
-			Host      string `json:"host"`
-			ProjectId string `json:"project_id"`
-			BranchId  string `json:"branch_id"`
-			Type      string `json:"type"`
-			CreatedAt string `json:"created_at"`
-		} `json:"endpoint"`
+		return EndpointState{}, fmt.Errorf("failed to update endpoint: %w", err)
 	}
 
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return EndpointState{}, fmt.Errorf("failed to unmarshal response: %v", err)
-	}
+	endpoint.RoleName, endpoint.DatabaseName = news.RoleName, news.DatabaseName
+	e.reconnect(ctx, client, endpoint, olds)
 
-	return EndpointState{
-		EndpointArgs: EndpointArgs{
-			ProjectId: result.Endpoint.ProjectId,
-			BranchId:  result.Endpoint.BranchId,
-			Type:      result.Endpoint.Type,
-		},
-		Id:        result.Endpoint.Id,
-		Host:      result.Endpoint.Host,
-		CreatedAt: result.Endpoint.CreatedAt,
-	}, nil
+	return *endpoint, nil
 }
 
 func (e Endpoint) Delete(ctx context.Context, id string, state EndpointState) error {
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return fmt.Errorf("missing configuration")
-	}
-
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/projects/%s/endpoints/%s", baseURL, state.ProjectId, state.Id), nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %v", err)
-	}
-
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	client, err := getClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to send request: %v", err)
+		return err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete endpoint: %s", string(body))
+	if err := client.DeleteEndpoint(ctx, state.ProjectId, state.EndpointId); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete endpoint: %w", err)
 	}
 
 	return nil
-}
\ No newline at end of file
+}
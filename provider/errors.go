@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// APIError is a non-2xx response from the Neon API.
+type APIError struct {
+	// StatusCode is the HTTP status of the response.
+	StatusCode int
+	// Code is Neon's machine readable error code, when one was sent.
+	Code string
+	// Message is the human readable explanation from Neon.
+	Message string
+	// RequestId identifies the request in Neon's logs, for support tickets.
+	RequestId string
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "API request failed with status %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Code != "" {
+		fmt.Fprintf(&b, " [%s]", e.Code)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ": %s", e.Message)
+	}
+	if e.RequestId != "" {
+		fmt.Fprintf(&b, " (request id %s)", e.RequestId)
+	}
+	return b.String()
+}
+
+// decodeError turns a non-2xx response into an *APIError, preferring the fields of Neon's
+// JSON error body and falling back to the raw payload.
+func decodeError(resp *http.Response, body []byte) error {
+	var payload struct {
+		Code      string `json:"code"`
+		Message   string `json:"message"`
+		RequestId string `json:"request_id"`
+	}
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    strings.TrimSpace(string(body)),
+		RequestId:  resp.Header.Get("X-Neon-Request-Id"),
+	}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		apiErr.Code = payload.Code
+		if payload.Message != "" {
+			apiErr.Message = payload.Message
+		}
+		if payload.RequestId != "" {
+			apiErr.RequestId = payload.RequestId
+		}
+	}
+	return apiErr
+}
+
+// hasStatus reports whether err wraps an *APIError with the given HTTP status.
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+// IsNotFound reports whether err means the requested Neon object does not exist.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// isClientError reports whether err is a 4xx response, which retrying does not fix.
+func isClientError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode >= 400 && apiErr.StatusCode < 500
+}
+
+// IsConflict reports whether err means the request clashes with an existing object.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsLocked reports whether err means the project is busy with another operation.
+func IsLocked(err error) bool {
+	return hasStatus(err, http.StatusLocked)
+}
+
+// IsRateLimited reports whether err means the API key has hit Neon's rate limit.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// OperationError means Neon applied a request, but the operations it started in response
+// did not finish in time or failed. Whatever the request created or changed exists.
+type OperationError struct {
+	Err error
+}
+
+func (e *OperationError) Error() string { return e.Err.Error() }
+
+func (e *OperationError) Unwrap() error { return e.Err }
+
+// IsOperationFailed reports whether err means a request was applied but the operations it
+// started did not finish.
+func IsOperationFailed(err error) bool {
+	var opErr *OperationError
+	return errors.As(err, &opErr)
+}
+
+// initFailed reports that a resource exists in Neon but did not finish setting up, so
+// that Pulumi keeps it in state and retries on the next update instead of leaking it.
+func initFailed(err error) error {
+	return infer.ResourceInitFailedError{Reasons: []string{err.Error()}}
+}
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// The functions below look up Neon objects that a stack reads but does not manage, such
+// as a project owned by a shared infrastructure stack.
+
+type GetProject struct{}
+
+func (f *GetProject) Annotate(a infer.Annotator) {
+	a.Describe(&f, "Look up a Neon project by its ID or, within an organization, by its name.")
+}
+
+type GetProjectArgs struct {
+	// ProjectId and Name select the project; exactly one of them must be set.
+	ProjectId *string `pulumi:"projectId,optional"`
+	Name      *string `pulumi:"name,optional"`
+	// OrgId is the organization searched for a project by name. It defaults to the
+	// provider's orgId.
+	OrgId *string `pulumi:"orgId,optional"`
+}
+
+func (args *GetProjectArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.ProjectId, "The ID of the project to look up. Exactly one of projectId and name must be set.")
+	a.Describe(&args.Name, "The name of the project to look up. Exactly one of projectId and name must be set.")
+	a.Describe(&args.OrgId, "The organization searched for a project by name. Defaults to the provider's orgId.")
+}
+
+type GetProjectResult struct {
+	ProjectArgs
+	ProjectId string `pulumi:"projectId"`
+	CreatedAt string `pulumi:"createdAt"`
+}
+
+func (r *GetProjectResult) Annotate(a infer.Annotator) {
+	a.Describe(&r.ProjectId, "The ID of the project.")
+	a.Describe(&r.CreatedAt, "When the project was created, in RFC 3339 format.")
+}
+
+func (GetProject) Call(ctx context.Context, args GetProjectArgs) (GetProjectResult, error) {
+	if (args.ProjectId == nil) == (args.Name == nil) {
+		return GetProjectResult{}, fmt.Errorf("exactly one of projectId and name must be set")
+	}
+	client, err := getClient(ctx)
+	if err != nil {
+		return GetProjectResult{}, err
+	}
+
+	if args.ProjectId != nil {
+		project, err := client.GetProject(ctx, *args.ProjectId)
+		if err != nil {
+			return GetProjectResult{}, fmt.Errorf("failed to get project %s: %w", *args.ProjectId, err)
+		}
+		return project.result(), nil
+	}
+
+	projects, err := client.ListProjects(ctx, args.OrgId)
+	if err != nil {
+		return GetProjectResult{}, fmt.Errorf("failed to list projects: %w", err)
+	}
+	var found *ProjectState
+	for _, project := range projects {
+		if project.Name != *args.Name {
+			continue
+		}
+		if found != nil {
+			return GetProjectResult{}, fmt.Errorf("more than one project is named %q; look it up by projectId instead", *args.Name)
+		}
+		found = project
+	}
+	if found == nil {
+		return GetProjectResult{}, fmt.Errorf("no project is named %q", *args.Name)
+	}
+	return found.result(), nil
+}
+
+func (s ProjectState) result() GetProjectResult {
+	return GetProjectResult{
+		ProjectArgs: s.ProjectArgs,
+		ProjectId:   s.ProjectId,
+		CreatedAt:   s.CreatedAt,
+	}
+}
+
+type GetBranch struct{}
+
+func (f *GetBranch) Annotate(a infer.Annotator) {
+	a.Describe(&f, "Look up a branch of a Neon project by its name.")
+}
+
+type GetBranchArgs struct {
+	ProjectId string `pulumi:"projectId"`
+	Name      string `pulumi:"name"`
+}
+
+func (args *GetBranchArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.ProjectId, "The ID of the project the branch belongs to.")
+	a.Describe(&args.Name, "The name of the branch to look up.")
+}
+
+type GetBranchResult struct {
+	ProjectId       string  `pulumi:"projectId"`
+	BranchId        string  `pulumi:"branchId"`
+	Name            string  `pulumi:"name"`
+	ParentId        *string `pulumi:"parentId,optional"`
+	ParentLsn       *string `pulumi:"parentLsn,optional"`
+	ParentTimestamp *string `pulumi:"parentTimestamp,optional"`
+	CreatedAt       string  `pulumi:"createdAt"`
+}
+
+func (r *GetBranchResult) Annotate(a infer.Annotator) {
+	a.Describe(&r.ProjectId, "The ID of the project the branch belongs to.")
+	a.Describe(&r.BranchId, "The ID of the branch.")
+	a.Describe(&r.Name, "The name of the branch.")
+	a.Describe(&r.ParentId, "The ID of the branch it was branched from.")
+	a.Describe(&r.ParentLsn, "The Log Sequence Number of the parent it was branched from.")
+	a.Describe(&r.ParentTimestamp, "The point in time of the parent it was branched from.")
+	a.Describe(&r.CreatedAt, "When the branch was created, in RFC 3339 format.")
+}
+
+func (GetBranch) Call(ctx context.Context, args GetBranchArgs) (GetBranchResult, error) {
+	client, err := getClient(ctx)
+	if err != nil {
+		return GetBranchResult{}, err
+	}
+	branch, err := client.GetBranchByName(ctx, args.ProjectId, args.Name)
+	if err != nil {
+		return GetBranchResult{}, fmt.Errorf("failed to get branch %q: %w", args.Name, err)
+	}
+	return GetBranchResult{
+		ProjectId:       branch.ProjectId,
+		BranchId:        branch.BranchId,
+		Name:            branch.Name,
+		ParentId:        branch.ParentId,
+		ParentLsn:       branch.ParentLsn,
+		ParentTimestamp: branch.ParentTimestamp,
+		CreatedAt:       branch.CreatedAt,
+	}, nil
+}
+
+type GetEndpoints struct{}
+
+func (f *GetEndpoints) Annotate(a infer.Annotator) {
+	a.Describe(&f, "List the compute endpoints on a branch of a Neon project.")
+}
+
+type GetEndpointsArgs struct {
+	ProjectId string `pulumi:"projectId"`
+	BranchId  string `pulumi:"branchId"`
+}
+
+func (args *GetEndpointsArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.ProjectId, "The ID of the project the branch belongs to.")
+	a.Describe(&args.BranchId, "The ID of the branch whose endpoints are listed.")
+}
+
+type GetEndpointsResult struct {
+	Endpoints []EndpointSummary `pulumi:"endpoints"`
+}
+
+func (r *GetEndpointsResult) Annotate(a infer.Annotator) {
+	a.Describe(&r.Endpoints, "The endpoints on the branch.")
+}
+
+// EndpointSummary describes an endpoint found by getEndpoints.
+type EndpointSummary struct {
+	EndpointId            string  `pulumi:"endpointId"`
+	Type                  string  `pulumi:"type"`
+	Host                  string  `pulumi:"host"`
+	PoolerHost            string  `pulumi:"poolerHost,optional"`
+	Port                  int     `pulumi:"port"`
+	RegionId              *string `pulumi:"regionId,optional"`
+	AutoscalingLimitMinCu float64 `pulumi:"autoscalingLimitMinCu"`
+	AutoscalingLimitMaxCu float64 `pulumi:"autoscalingLimitMaxCu"`
+	SuspendTimeoutSeconds int     `pulumi:"suspendTimeoutSeconds"`
+	CurrentState          string  `pulumi:"currentState,optional"`
+	CreatedAt             string  `pulumi:"createdAt"`
+}
+
+func (e *EndpointSummary) Annotate(a infer.Annotator) {
+	a.Describe(&e, "A compute endpoint found by getEndpoints.")
+	a.Describe(&e.EndpointId, "The ID of the endpoint.")
+	a.Describe(&e.Type, "The endpoint type, read_write or read_only.")
+	a.Describe(&e.Host, "The hostname of the endpoint.")
+	a.Describe(&e.PoolerHost, "The hostname that routes connections through PgBouncer.")
+	a.Describe(&e.Port, "The port the endpoint accepts connections on.")
+	a.Describe(&e.RegionId, "The region of the endpoint.")
+	a.Describe(&e.AutoscalingLimitMinCu, "The minimum compute size, in compute units.")
+	a.Describe(&e.AutoscalingLimitMaxCu, "The maximum compute size, in compute units.")
+	a.Describe(&e.SuspendTimeoutSeconds, "How long, in seconds, an idle endpoint keeps running before it is suspended.")
+	a.Describe(&e.CurrentState, "The state of the endpoint's compute, such as active or idle.")
+	a.Describe(&e.CreatedAt, "When the endpoint was created, in RFC 3339 format.")
+}
+
+func (GetEndpoints) Call(ctx context.Context, args GetEndpointsArgs) (GetEndpointsResult, error) {
+	client, err := getClient(ctx)
+	if err != nil {
+		return GetEndpointsResult{}, err
+	}
+	endpoints, err := client.ListBranchEndpoints(ctx, args.ProjectId, args.BranchId)
+	if err != nil {
+		return GetEndpointsResult{}, fmt.Errorf("failed to list endpoints of branch %s: %w", args.BranchId, err)
+	}
+
+	result := GetEndpointsResult{Endpoints: make([]EndpointSummary, 0, len(endpoints))}
+	for _, endpoint := range endpoints {
+		state := endpoint.state()
+		result.Endpoints = append(result.Endpoints, EndpointSummary{
+			EndpointId:            state.EndpointId,
+			Type:                  state.Type,
+			Host:                  state.Host,
+			PoolerHost:            state.PoolerHost,
+			Port:                  state.Port,
+			RegionId:              state.RegionId,
+			AutoscalingLimitMinCu: endpoint.AutoscalingLimitMinCu,
+			AutoscalingLimitMaxCu: endpoint.AutoscalingLimitMaxCu,
+			SuspendTimeoutSeconds: endpoint.SuspendTimeoutSeconds,
+			CurrentState:          state.CurrentState,
+			CreatedAt:             state.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+type GetRegions struct{}
+
+func (f *GetRegions) Annotate(a infer.Annotator) {
+	a.Describe(&f, "List the regions Neon can create projects in.")
+}
+
+type GetRegionsArgs struct{}
+
+type GetRegionsResult struct {
+	Regions []Region `pulumi:"regions"`
+}
+
+func (r *GetRegionsResult) Annotate(a infer.Annotator) {
+	a.Describe(&r.Regions, "The regions Neon can create projects in.")
+}
+
+type Region struct {
+	RegionId string `pulumi:"regionId"`
+	Name     string `pulumi:"name"`
+	// Default is true for the region projects are created in when none is given.
+	Default bool `pulumi:"default"`
+}
+
+func (r *Region) Annotate(a infer.Annotator) {
+	a.Describe(&r, "A region Neon can create projects in.")
+	a.Describe(&r.RegionId, "The ID of the region, such as aws-us-east-2.")
+	a.Describe(&r.Name, "The human readable name of the region.")
+	a.Describe(&r.Default, "Whether projects are created in this region when they do not set one.")
+}
+
+func (GetRegions) Call(ctx context.Context, args GetRegionsArgs) (GetRegionsResult, error) {
+	client, err := getClient(ctx)
+	if err != nil {
+		return GetRegionsResult{}, err
+	}
+	regions, err := client.listRegions(ctx)
+	if err != nil {
+		return GetRegionsResult{}, fmt.Errorf("failed to list regions: %w", err)
+	}
+
+	result := GetRegionsResult{
+		Regions: make([]Region, 0, len(regions)),
+	}
+	for _, region := range regions {
+		result.Regions = append(result.Regions, Region{
+			RegionId: region.RegionId,
+			Name:     region.Name,
+			Default:  region.Default,
+		})
+	}
+	sort.Slice(result.Regions, func(i, j int) bool {
+		return result.Regions[i].RegionId < result.Regions[j].RegionId
+	})
+	return result, nil
+}
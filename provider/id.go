@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Import ID formats. The Pulumi ID of every resource is built from the Neon identifiers
+// needed to look it up, so that `pulumi import` can adopt an existing object from its ID
+// alone. Roles are keyed by name, which cannot change. Databases can be renamed, so they
+// are keyed by the numeric ID Neon keeps across renames, and an import may give the
+// database's name in its place.
+const (
+	branchIDFormat   = "projectId/branchId"
+	endpointIDFormat = "projectId/endpointId"
+	databaseIDFormat = "projectId/branchId/databaseId"
+	roleIDFormat     = "projectId/branchId/roleName"
+)
+
+func resourceID(parts ...string) string {
+	return strings.Join(parts, "/")
+}
+
+// parseResourceID splits id into as many parts as format has, rejecting empty parts.
+func parseResourceID(id, format string) ([]string, error) {
+	want := strings.Count(format, "/") + 1
+	parts := strings.SplitN(id, "/", want)
+	if len(parts) != want {
+		return nil, fmt.Errorf("invalid ID %q: expected %s", id, format)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("invalid ID %q: expected %s", id, format)
+		}
+	}
+	return parts, nil
+}
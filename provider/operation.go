@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+)
+
+const defaultPollInterval = time.Second
+
+// apiOperation is an asynchronous action Neon starts in response to a mutation, such as
+// starting compute for a new endpoint or creating a database on a branch.
+type apiOperation struct {
+	Id         string `json:"id"`
+	ProjectId  string `json:"project_id"`
+	BranchId   string `json:"branch_id"`
+	EndpointId string `json:"endpoint_id"`
+	Action     string `json:"action"`
+	Status     string `json:"status"`
+	Error      string `json:"error"`
+}
+
+// done reports whether the operation has stopped running, and returns an error if it
+// stopped without succeeding.
+func (o apiOperation) done() (bool, error) {
+	switch o.Status {
+	case "finished", "skipped":
+		return true, nil
+	case "failed", "error", "cancelled":
+		if o.Error != "" {
+			return true, fmt.Errorf("operation %s (%s) %s: %s", o.Id, o.Action, o.Status, o.Error)
+		}
+		return true, fmt.Errorf("operation %s (%s) %s", o.Id, o.Action, o.Status)
+	}
+	return false, nil
+}
+
+func (c *Client) getOperation(ctx context.Context, projectId, operationId string) (*apiOperation, error) {
+	var result struct {
+		Operation apiOperation `json:"operation"`
+	}
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/operations/%s", projectId, operationId), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result.Operation, nil
+}
+
+// waitForOperations blocks until every operation has finished, returning the first
+// failure. It gives up when ctx is done, which carries the resource's custom timeout.
+func (c *Client) waitForOperations(ctx context.Context, projectId string, operations []apiOperation) error {
+	for _, op := range operations {
+		if err := c.waitForOperation(ctx, projectId, op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) waitForOperation(ctx context.Context, projectId string, op apiOperation) error {
+	if op.ProjectId != "" {
+		projectId = op.ProjectId
+	}
+	for {
+		done, err := op.done()
+		if done {
+			return err
+		}
+
+		log.Printf("Waiting for operation %s (%s) to finish: %s", op.Id, op.Action, op.Status)
+		if err := sleep(ctx, c.pollInterval); err != nil {
+			return fmt.Errorf("timed out waiting for operation %s (%s): %v", op.Id, op.Action, err)
+		}
+
+		current, err := c.getOperation(ctx, projectId, op.Id)
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("timed out waiting for operation %s (%s): %v", op.Id, op.Action, ctx.Err())
+			}
+			return fmt.Errorf("failed to poll operation %s: %w", op.Id, err)
+		}
+		op = *current
+	}
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Preflight checks compare inputs with facts about the account that are cheap to fetch,
+// so that previews report mistakes which would otherwise only surface halfway through an
+// update. A check that cannot reach the API is skipped rather than failing the preview.
+// The facts are fetched with a single short request, and a failure is remembered like a
+// success, so that an unreachable API does not hold up every resource in the preview.
+
+type apiRegion struct {
+	RegionId string `json:"region_id"`
+	Name     string `json:"name"`
+	Default  bool   `json:"default"`
+}
+
+// preflightTimeout bounds the single request each preflight check makes.
+const preflightTimeout = 10 * time.Second
+
+// preflightCache remembers the account facts, or the error fetching them, for the life
+// of the provider process.
+type preflightCache struct {
+	mu             sync.Mutex
+	regionsFetched bool
+	regions        []apiRegion
+	regionsErr     error
+	limitFetched   bool
+	maxCu          float64
+	limitErr       error
+}
+
+func (c *Client) listRegions(ctx context.Context) ([]apiRegion, error) {
+	var result struct {
+		Regions []apiRegion `json:"regions"`
+	}
+	if err := c.doRequest(ctx, http.MethodGet, "/regions", nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Regions, nil
+}
+
+// fetchOnce gets path with one attempt bounded by preflightTimeout, without the retries
+// of doRequest.
+func (c *Client) fetchOnce(ctx context.Context, path string, out interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, preflightTimeout)
+	defer cancel()
+	body, _, err := c.send(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %v", err)
+	}
+	return nil
+}
+
+func (c *Client) cachedRegions(ctx context.Context) ([]apiRegion, error) {
+	c.preflight.mu.Lock()
+	defer c.preflight.mu.Unlock()
+	if !c.preflight.regionsFetched {
+		var result struct {
+			Regions []apiRegion `json:"regions"`
+		}
+		c.preflight.regionsErr = c.fetchOnce(ctx, "/regions", &result)
+		c.preflight.regions = result.Regions
+		c.preflight.regionsFetched = true
+	}
+	return c.preflight.regions, c.preflight.regionsErr
+}
+
+// cachedComputeLimit returns the largest compute size, in compute units, that the
+// account's plan allows.
+func (c *Client) cachedComputeLimit(ctx context.Context) (float64, error) {
+	c.preflight.mu.Lock()
+	defer c.preflight.mu.Unlock()
+	if !c.preflight.limitFetched {
+		var user struct {
+			MaxAutoscalingLimit float64 `json:"max_autoscaling_limit"`
+		}
+		c.preflight.limitErr = c.fetchOnce(ctx, "/users/me", &user)
+		c.preflight.maxCu = user.MaxAutoscalingLimit
+		c.preflight.limitFetched = true
+	}
+	return c.preflight.maxCu, c.preflight.limitErr
+}
+
+// checkRegion fails if Neon does not offer regionId.
+func (c *Client) checkRegion(ctx context.Context, regionId string) error {
+	if regionId == "" {
+		return nil
+	}
+	regions, err := c.cachedRegions(ctx)
+	if err != nil || len(regions) == 0 {
+		log.Printf("Skipping region check for %s: %v", regionId, err)
+		return nil
+	}
+	available := make([]string, 0, len(regions))
+	for _, region := range regions {
+		if region.RegionId == regionId {
+			return nil
+		}
+		available = append(available, region.RegionId)
+	}
+	sort.Strings(available)
+	return fmt.Errorf("region %q is not available; choose one of %s", regionId, strings.Join(available, ", "))
+}
+
+// checkComputeLimit fails if maxCu exceeds the largest compute size the account's plan
+// allows.
+func (c *Client) checkComputeLimit(ctx context.Context, maxCu *float64) error {
+	if maxCu == nil || *maxCu == 0 {
+		return nil
+	}
+	limit, err := c.cachedComputeLimit(ctx)
+	if err != nil {
+		log.Printf("Skipping compute limit check: %v", err)
+		return nil
+	}
+	if limit > 0 && *maxCu > limit {
+		return fmt.Errorf("autoscalingLimitMaxCu %g exceeds the plan's limit of %g compute units", *maxCu, limit)
+	}
+	return nil
+}
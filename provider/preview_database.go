@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// PreviewDatabase wires up the branch, compute endpoint, role and database that a pull
+// request preview environment needs. Its children are ordinary Branch, Endpoint, Role and
+// Database resources, so they are created, updated and deleted like any others.
+type PreviewDatabase struct{}
+
+func (d *PreviewDatabase) Annotate(a infer.Annotator) {
+	a.Describe(&d, "A branch of a Neon project with its own compute endpoint, role and database, "+
+		"for short-lived preview environments.")
+}
+
+type PreviewDatabaseArgs struct {
+	ProjectId pulumi.StringInput `pulumi:"projectId"`
+	// ParentBranchId is the branch the preview is copied from. It defaults to the
+	// project's default branch.
+	ParentBranchId pulumi.StringPtrInput `pulumi:"parentBranchId,optional"`
+	// Name is the name of the preview's branch.
+	Name pulumi.StringInput `pulumi:"name"`
+	// RoleName and DatabaseName name the role and database created on the branch. They
+	// must not already exist on the parent branch, since branches copy their parent's.
+	RoleName     pulumi.StringPtrInput `pulumi:"roleName,optional"`
+	DatabaseName pulumi.StringPtrInput `pulumi:"databaseName,optional"`
+	// Ttl is how long the preview lives, as a duration such as 72h. Neon deletes the
+	// branch once it expires, and expiresAt reports when that is for cleanup tooling.
+	Ttl pulumi.StringPtrInput `pulumi:"ttl,optional"`
+}
+
+func (args *PreviewDatabaseArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.ProjectId, "The ID of the project to create the preview in.")
+	a.Describe(&args.ParentBranchId, "The branch the preview is copied from. Defaults to the project's default branch.")
+	a.Describe(&args.Name, "The name of the preview's branch.")
+	a.Describe(&args.RoleName, "The role created on the branch. It must not already exist on the parent branch.")
+	a.Describe(&args.DatabaseName, "The database created on the branch. It must not already exist on the parent branch.")
+	a.Describe(&args.Ttl, "How long the preview lives, as a duration such as 72h. Neon deletes the branch once it expires.")
+}
+
+type PreviewDatabaseState struct {
+	pulumi.ResourceState
+	BranchId     pulumi.StringOutput `pulumi:"branchId"`
+	EndpointId   pulumi.StringOutput `pulumi:"endpointId"`
+	Host         pulumi.StringOutput `pulumi:"host"`
+	RoleName     pulumi.StringOutput `pulumi:"roleName"`
+	DatabaseName pulumi.StringOutput `pulumi:"databaseName"`
+	// Dsn and PooledDsn connect to the database as the role, directly and through the
+	// connection pooler.
+	Dsn       pulumi.StringOutput `pulumi:"dsn" provider:"secret"`
+	PooledDsn pulumi.StringOutput `pulumi:"pooledDsn" provider:"secret"`
+	CreatedAt pulumi.StringOutput `pulumi:"createdAt"`
+	// ExpiresAt is when the preview's ttl runs out, counted from the branch's creation.
+	ExpiresAt pulumi.StringPtrOutput `pulumi:"expiresAt,optional"`
+}
+
+func (s *PreviewDatabaseState) Annotate(a infer.Annotator) {
+	a.Describe(&s.BranchId, "The ID of the preview's branch.")
+	a.Describe(&s.EndpointId, "The ID of the preview's read-write endpoint.")
+	a.Describe(&s.Host, "The hostname of the preview's endpoint.")
+	a.Describe(&s.RoleName, "The role created on the branch.")
+	a.Describe(&s.DatabaseName, "The database created on the branch.")
+	a.Describe(&s.Dsn, "A connection URI for the database as the role.")
+	a.Describe(&s.PooledDsn, "A connection URI for the database as the role that goes through the connection pooler.")
+	a.Describe(&s.CreatedAt, "When the preview's branch was created, in RFC 3339 format.")
+	a.Describe(&s.ExpiresAt, "When the preview's ttl runs out, in RFC 3339 format.")
+}
+
+const (
+	defaultPreviewRoleName     = "preview_owner"
+	defaultPreviewDatabaseName = "preview"
+)
+
+// The child resources are registered by token with just the outputs the component uses,
+// rather than through the generated Go SDK, so that the provider does not depend on code
+// generated from its own schema.
+
+type previewBranch struct {
+	pulumi.CustomResourceState
+	BranchId  pulumi.StringOutput    `pulumi:"branchId"`
+	CreatedAt pulumi.StringOutput    `pulumi:"createdAt"`
+	ExpiresAt pulumi.StringPtrOutput `pulumi:"expiresAt"`
+}
+
+type previewEndpoint struct {
+	pulumi.CustomResourceState
+	EndpointId          pulumi.StringOutput `pulumi:"endpointId"`
+	Host                pulumi.StringOutput `pulumi:"host"`
+	ConnectionUri       pulumi.StringOutput `pulumi:"connectionUri"`
+	PooledConnectionUri pulumi.StringOutput `pulumi:"pooledConnectionUri"`
+}
+
+type previewRole struct {
+	pulumi.CustomResourceState
+	Name pulumi.StringOutput `pulumi:"name"`
+}
+
+type previewDatabase struct {
+	pulumi.CustomResourceState
+	Name pulumi.StringOutput `pulumi:"name"`
+}
+
+func resourceToken(typ string) string {
+	return fmt.Sprintf("%s:index:%s", Name, typ)
+}
+
+func (PreviewDatabase) Construct(ctx *pulumi.Context, name, typ string, args PreviewDatabaseArgs, opts pulumi.ResourceOption) (*PreviewDatabaseState, error) {
+	comp := &PreviewDatabaseState{}
+	if err := ctx.RegisterComponentResource(typ, name, comp, opts); err != nil {
+		return nil, err
+	}
+	parent := pulumi.Parent(comp)
+
+	roleName := pulumi.StringInput(pulumi.String(defaultPreviewRoleName))
+	if args.RoleName != nil {
+		roleName = args.RoleName.ToStringPtrOutput().Elem()
+	}
+	databaseName := pulumi.StringInput(pulumi.String(defaultPreviewDatabaseName))
+	if args.DatabaseName != nil {
+		databaseName = args.DatabaseName.ToStringPtrOutput().Elem()
+	}
+
+	branchArgs := pulumi.Map{
+		"projectId": args.ProjectId,
+		"name":      args.Name,
+	}
+	if args.ParentBranchId != nil {
+		branchArgs["parentId"] = args.ParentBranchId
+	}
+	if args.Ttl != nil {
+		branchArgs["ttl"] = args.Ttl
+	}
+	var branch previewBranch
+	if err := ctx.RegisterResource(resourceToken("Branch"), name+"-branch", branchArgs, &branch, parent); err != nil {
+		return nil, err
+	}
+
+	var role previewRole
+	if err := ctx.RegisterResource(resourceToken("Role"), name+"-role", pulumi.Map{
+		"projectId": args.ProjectId,
+		"branchId":  branch.BranchId,
+		"name":      roleName,
+	}, &role, parent); err != nil {
+		return nil, err
+	}
+
+	var database previewDatabase
+	if err := ctx.RegisterResource(resourceToken("Database"), name+"-database", pulumi.Map{
+		"projectId": args.ProjectId,
+		"branchId":  branch.BranchId,
+		"name":      databaseName,
+		"ownerName": role.Name,
+	}, &database, parent); err != nil {
+		return nil, err
+	}
+
+	// The endpoint builds its connection URIs when it is created, so it waits for the
+	// role and database to exist.
+	var endpoint previewEndpoint
+	if err := ctx.RegisterResource(resourceToken("Endpoint"), name+"-endpoint", pulumi.Map{
+		"projectId":    args.ProjectId,
+		"branchId":     branch.BranchId,
+		"type":         pulumi.String("read_write"),
+		"roleName":     role.Name,
+		"databaseName": database.Name,
+	}, &endpoint, parent, pulumi.DependsOn([]pulumi.Resource{&role, &database})); err != nil {
+		return nil, err
+	}
+
+	comp.BranchId = branch.BranchId
+	comp.CreatedAt = branch.CreatedAt
+	comp.EndpointId = endpoint.EndpointId
+	comp.Host = endpoint.Host
+	comp.RoleName = role.Name
+	comp.DatabaseName = database.Name
+	comp.Dsn = pulumi.ToSecret(endpoint.ConnectionUri).(pulumi.StringOutput)
+	comp.PooledDsn = pulumi.ToSecret(endpoint.PooledConnectionUri).(pulumi.StringOutput)
+	comp.ExpiresAt = branch.ExpiresAt
+	return comp, nil
+}
@@ -1,256 +1,251 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 )
 
 type Project struct{}
 
+func (p *Project) Annotate(a infer.Annotator) {
+	a.Describe(&p, "A Neon project. Import it with its project ID.")
+}
+
+// ProjectArgs configures a Neon project. Optional settings that are left unset keep
+// whatever Neon chooses by default, and are never reported as drift.
 type ProjectArgs struct {
 	Name     string `pulumi:"name"`
 	RegionId string `pulumi:"regionId"`
+	// OrgId is the organization that owns the project. It defaults to the provider's
+	// orgId, and changing it replaces the project.
+	OrgId *string `pulumi:"orgId,optional"`
+	// PgVersion is the major Postgres version. Changing it replaces the project.
+	PgVersion *int `pulumi:"pgVersion,optional"`
+	// Provisioner is the compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces
+	// the project.
+	Provisioner *string `pulumi:"provisioner,optional"`
+	// StorePasswords controls whether Neon keeps role passwords so they can be revealed.
+	// The API cannot change it after creation, so changing it replaces the project.
+	StorePasswords          *bool                    `pulumi:"storePasswords,optional"`
+	HistoryRetentionSeconds *int                     `pulumi:"historyRetentionSeconds,optional"`
+	DefaultEndpointSettings *DefaultEndpointSettings `pulumi:"defaultEndpointSettings,optional"`
+}
+
+func (args *ProjectArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.Name, "The name of the project.")
+	a.Describe(&args.RegionId, "The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.")
+	a.Describe(&args.OrgId, "The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.")
+	a.Describe(&args.PgVersion, "The major Postgres version. Changing it replaces the project.")
+	a.Describe(&args.Provisioner, "The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.")
+	a.Describe(&args.StorePasswords, "Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.")
+	a.Describe(&args.HistoryRetentionSeconds, "How long, in seconds, Neon keeps the history that branches can be created from.")
+	a.Describe(&args.DefaultEndpointSettings, "The compute settings applied to endpoints created in the project.")
+}
+
+// DefaultEndpointSettings are the compute settings applied to endpoints created in a
+// project.
+type DefaultEndpointSettings struct {
+	AutoscalingLimitMinCu *float64 `pulumi:"autoscalingLimitMinCu,optional"`
+	AutoscalingLimitMaxCu *float64 `pulumi:"autoscalingLimitMaxCu,optional"`
+	SuspendTimeoutSeconds *int     `pulumi:"suspendTimeoutSeconds,optional"`
+}
+
+func (s *DefaultEndpointSettings) Annotate(a infer.Annotator) {
+	a.Describe(&s, "Compute settings applied to the endpoints created in a project.")
+	a.Describe(&s.AutoscalingLimitMinCu, "The minimum compute size, in compute units.")
+	a.Describe(&s.AutoscalingLimitMaxCu, "The maximum compute size, in compute units.")
+	a.Describe(&s.SuspendTimeoutSeconds, "How long, in seconds, an idle endpoint keeps running before it is suspended.")
 }
 
 type ProjectState struct {
 	ProjectArgs
-	Id        string `pulumi:"id"`
+	ProjectDefaults
+	ProjectId string `pulumi:"projectId"`
 	CreatedAt string `pulumi:"createdAt"`
 }
 
-func (p Project) Create(ctx context.Context, name string, input ProjectArgs, preview bool) (string, ProjectState, error) {
-	if preview {
-		return name, ProjectState{ProjectArgs: input}, nil
-	}
-
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return "", ProjectState{}, fmt.Errorf("missing configuration")
-	}
+func (s *ProjectState) Annotate(a infer.Annotator) {
+	a.Describe(&s.ProjectId, "The ID Neon assigned to the project.")
+	a.Describe(&s.CreatedAt, "When the project was created, in RFC 3339 format.")
+}
 
-	projectData := struct {
-		Name     string `json:"name"`
-		RegionId string `json:"region_id"`
-	}{
-		Name:     input.Name,
-		RegionId: input.RegionId,
-	}
+// ProjectDefaults describes the branch, read-write endpoint, owner role and database Neon
+// creates along with every project, so that simple stacks can connect without declaring
+// any other resources.
+type ProjectDefaults struct {
+	DefaultBranchId     string `pulumi:"defaultBranchId,optional"`
+	DefaultEndpointHost string `pulumi:"defaultEndpointHost,optional"`
+	DefaultRoleName     string `pulumi:"defaultRoleName,optional"`
+	DefaultDatabaseName string `pulumi:"defaultDatabaseName,optional"`
+	ConnectionUri       string `pulumi:"connectionUri,optional" provider:"secret"`
+}
 
-	jsonData, err := json.Marshal(map[string]interface{}{"project": projectData})
-	if err != nil {
-		return "", ProjectState{}, fmt.Errorf("failed to marshal project data: %v", err)
-	}
+func (d *ProjectDefaults) Annotate(a infer.Annotator) {
+	a.Describe(&d.DefaultBranchId, "The ID of the branch Neon created along with the project.")
+	a.Describe(&d.DefaultEndpointHost, "The hostname of the read-write endpoint on the default branch.")
+	a.Describe(&d.DefaultRoleName, "The role Neon created along with the project. It owns the default database.")
+	a.Describe(&d.DefaultDatabaseName, "The database Neon created along with the project.")
+	a.Describe(&d.ConnectionUri, "A connection URI for the default database as the default role. It embeds the role's password.")
+}
 
-	req, err := http.NewRequest("POST", baseURL+"/projects", bytes.NewBuffer(jsonData))
-	if err != nil {
-		return "", ProjectState{}, fmt.Errorf("failed to create request: %v", err)
-	}
+// WireDependencies marks the connection URI secret, since it embeds the role's password.
+// An update never changes the project's ID or defaults, so a preview keeps them known.
+func (p Project) WireDependencies(f infer.FieldSelector, args *ProjectArgs, state *ProjectState) {
+	f.OutputField(&state.ConnectionUri).AlwaysSecret()
+	f.OutputField(&state.Name).DependsOn(f.InputField(&args.Name))
+}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
+// Check validates the project's inputs before anything is sent to Neon.
+func (p Project) Check(ctx context.Context, name string, oldInputs, newInputs resource.PropertyMap) (ProjectArgs, []p.CheckFailure, error) {
+	args, failures, err := infer.DefaultCheck[ProjectArgs](ctx, newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+
+	c := newChecker(newInputs)
+	c.check("regionId", validRegionID)
+	c.check("orgId", validOrgID)
+	c.check("provisioner", validProvisioner)
+	c.checkObject("defaultEndpointSettings", func(settings resource.PropertyMap, path string) {
+		c.checkComputeLimits(settings, path+".")
+	})
+	return args, c.failures, nil
+}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", ProjectState{}, fmt.Errorf("failed to send request: %v", err)
+// checkComputeLimit checks the default endpoint settings against the account's plan.
+func (args ProjectArgs) checkComputeLimit(ctx context.Context, client *Client) error {
+	if args.DefaultEndpointSettings == nil {
+		return nil
 	}
-	defer resp.Body.Close()
+	return client.checkComputeLimit(ctx, args.DefaultEndpointSettings.AutoscalingLimitMaxCu)
+}
 
-	body, err := io.ReadAll(resp.Body)
+func (p Project) Create(ctx context.Context, name string, input ProjectArgs, preview bool) (string, ProjectState, error) {
+	client, err := getClient(ctx)
 	if err != nil {
-		return "", ProjectState{}, fmt.Errorf("failed to read response body: %v", err)
+		return "", ProjectState{}, err
 	}
-
-	if resp.StatusCode != http.StatusCreated {
-		return "", ProjectState{}, fmt.Errorf("failed to create project: %s", string(body))
+	if preview {
+		if err := client.checkRegion(ctx, input.RegionId); err != nil {
+			return "", ProjectState{}, err
+		}
+		if err := input.checkComputeLimit(ctx, client); err != nil {
+			return "", ProjectState{}, err
+		}
+		return "", ProjectState{ProjectArgs: input}, nil
 	}
 
-	var result struct {
-		Project struct {
-			Id        string `json:"id"`
-			Name      string `json:"name"`
-			RegionId  string `json:"region_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"project"`
+	project, err := client.CreateProject(ctx, input)
+	if IsOperationFailed(err) {
+		return project.ProjectId, *project, initFailed(err)
 	}
-
-	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return "", ProjectState{}, fmt.Errorf("failed to unmarshal response: %v", err)
+		return "", ProjectState{}, fmt.Errorf("failed to create project: %w", err)
 	}
 
-	return name, ProjectState{
-		ProjectArgs: ProjectArgs{
-			Name:     result.Project.Name,
-			RegionId: result.Project.RegionId,
-		},
-		Id:        result.Project.Id,
-		CreatedAt: result.Project.CreatedAt,
-	}, nil
+	return project.ProjectId, *project, nil
 }
 
 func (p Project) Read(ctx context.Context, id string, inputs ProjectArgs, state ProjectState) (string, ProjectArgs, ProjectState, error) {
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return "", ProjectArgs{}, ProjectState{}, fmt.Errorf("missing configuration")
-	}
-
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/projects/%s", baseURL, state.Id), nil)
+	client, err := getClient(ctx)
 	if err != nil {
-		return "", ProjectArgs{}, ProjectState{}, fmt.Errorf("failed to create request: %v", err)
+		return "", ProjectArgs{}, ProjectState{}, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", ProjectArgs{}, ProjectState{}, fmt.Errorf("failed to send request: %v", err)
+	// Imported projects have no state yet, and their ID is the Neon project ID.
+	projectId := state.ProjectId
+	if projectId == "" {
+		projectId = id
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	project, err := client.GetProject(ctx, projectId)
 	if err != nil {
-		return "", ProjectArgs{}, ProjectState{}, fmt.Errorf("failed to read response body: %v", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		if IsNotFoundError(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))) {
+		if IsNotFound(err) {
 			return "", ProjectArgs{}, ProjectState{}, nil
 		}
-		return "", ProjectArgs{}, ProjectState{}, fmt.Errorf("failed to read project: %s", string(body))
+		return "", ProjectArgs{}, ProjectState{}, fmt.Errorf("failed to read project: %w", err)
 	}
 
-	var result struct {
-		Project struct {
-			Id        string `json:"id"`
-			Name      string `json:"name"`
-			RegionId  string `json:"region_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"project"`
+	// The project response does not include its defaults, so they are looked up
+	// separately to pick up changes such as a new default branch.
+	defaults, err := client.GetProjectDefaults(ctx, projectId, state.ProjectDefaults)
+	if err != nil {
+		return "", ProjectArgs{}, ProjectState{}, fmt.Errorf("failed to read project defaults: %w", err)
 	}
+	project.ProjectDefaults = *defaults
 
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return "", ProjectArgs{}, ProjectState{}, fmt.Errorf("failed to unmarshal response: %v", err)
+	return project.ProjectId, project.ProjectArgs, *project, nil
+}
+
+// Diff compares the program's inputs with the last known state of the project. Only
+// the name, history retention and default endpoint settings can be changed in place.
+func (p Project) Diff(ctx context.Context, id string, olds ProjectState, news ProjectArgs) (p.DiffResponse, error) {
+	diff := diffBuilder{}
+	diff.update("name", olds.Name != news.Name)
+	diff.replace("regionId", olds.RegionId != news.RegionId)
+	diff.replace("orgId", optionalChanged(olds.OrgId, news.OrgId))
+	diff.replace("pgVersion", optionalChanged(olds.PgVersion, news.PgVersion))
+	diff.replace("provisioner", optionalChanged(olds.Provisioner, news.Provisioner))
+	diff.replace("storePasswords", optionalChanged(olds.StorePasswords, news.StorePasswords))
+	diff.update("historyRetentionSeconds", optionalChanged(olds.HistoryRetentionSeconds, news.HistoryRetentionSeconds))
+
+	if settings := news.DefaultEndpointSettings; settings != nil {
+		old := olds.DefaultEndpointSettings
+		if old == nil {
+			old = &DefaultEndpointSettings{}
+		}
+		diff.update("defaultEndpointSettings.autoscalingLimitMinCu", optionalChanged(old.AutoscalingLimitMinCu, settings.AutoscalingLimitMinCu))
+		diff.update("defaultEndpointSettings.autoscalingLimitMaxCu", optionalChanged(old.AutoscalingLimitMaxCu, settings.AutoscalingLimitMaxCu))
+		diff.update("defaultEndpointSettings.suspendTimeoutSeconds", optionalChanged(old.SuspendTimeoutSeconds, settings.SuspendTimeoutSeconds))
 	}
 
-	return id, ProjectArgs{
-		Name:     result.Project.Name,
-		RegionId: result.Project.RegionId,
-	}, ProjectState{
-		ProjectArgs: ProjectArgs{
-			Name:     result.Project.Name,
-			RegionId: result.Project.RegionId,
-		},
-		Id:        result.Project.Id,
-		CreatedAt: result.Project.CreatedAt,
-	}, nil
+	return diff.response(), nil
 }
 
 func (p Project) Update(ctx context.Context, id string, olds ProjectState, news ProjectArgs, preview bool) (ProjectState, error) {
 	if preview {
+		client, err := getClient(ctx)
+		if err != nil {
+			return ProjectState{}, err
+		}
+		if err := news.checkComputeLimit(ctx, client); err != nil {
+			return ProjectState{}, err
+		}
 		return ProjectState{
-			ProjectArgs: news,
-			Id:          olds.Id,
-			CreatedAt:   olds.CreatedAt,
+			ProjectArgs:     news,
+			ProjectDefaults: olds.ProjectDefaults,
+			ProjectId:       olds.ProjectId,
+			CreatedAt:       olds.CreatedAt,
 		}, nil
 	}
 
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return ProjectState{}, fmt.Errorf("missing configuration")
-	}
-
-	projectData := struct {
-		Name string `json:"name"`
-	}{
-		Name: news.Name,
-	}
-
-	jsonData, err := json.Marshal(map[string]interface{}{"project": projectData})
-	if err != nil {
-		return ProjectState{}, fmt.Errorf("failed to marshal project data: %v", err)
-	}
-
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/projects/%s", baseURL, olds.Id), bytes.NewBuffer(jsonData))
-	if err != nil {
-		return ProjectState{}, fmt.Errorf("failed to create request: %v", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	client, err := getClient(ctx)
 	if err != nil {
-		return ProjectState{}, fmt.Errorf("failed to send request: %v", err)
+		return ProjectState{}, err
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	project, err := client.UpdateProject(ctx, olds.ProjectId, news)
 	if err != nil {
-		return ProjectState{}, fmt.Errorf("failed to read response body: %v", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return ProjectState{}, fmt.Errorf("failed to update project: %s", string(body))
+		return ProjectState{}, fmt.Errorf("failed to update project: %w", err)
 	}
 
-	var result struct {
-		Project struct {
-			Id        string `json:"id"`
-			Name      string `json:"name"`
-			RegionId  string `json:"region_id"`
-			CreatedAt string `json:"created_at"`
-		} `json:"project"`
-	}
-
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return ProjectState{}, fmt.Errorf("failed to unmarshal response: %v", err)
-	}
-
-	return ProjectState{
-		ProjectArgs: ProjectArgs{
-			Name:     result.Project.Name,
-			RegionId: result.Project.RegionId,
-		},
-		Id:        result.Project.Id,
-		CreatedAt: result.Project.CreatedAt,
-	}, nil
+	project.ProjectDefaults = olds.ProjectDefaults
+	return *project, nil
 }
 
 func (p Project) Delete(ctx context.Context, id string, state ProjectState) error {
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return fmt.Errorf("missing configuration")
-	}
-
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/projects/%s", baseURL, state.Id), nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %v", err)
-	}
-
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	client, err := getClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to send request: %v", err)
+		return err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete project: %s", string(body))
+	if err := client.DeleteProject(ctx, state.ProjectId); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete project: %w", err)
 	}
 
 	return nil
-}
\ No newline at end of file
+}
@@ -1,11 +1,16 @@
 package provider
 
 import (
+	"context"
 	"fmt"
-	"strings"
+	"net/url"
+	"os"
+	"time"
 
 	"github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi-go-provider/middleware/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 )
 
@@ -16,7 +21,7 @@ const Name string = "neon"
 
 func Provider() provider.Provider {
 	// We tell the provider what resources it needs to support.
-	return infer.Provider(infer.Options{
+	return withBranchMethods(withSecretReads(infer.Provider(infer.Options{
 		Resources: []infer.InferredResource{
 			infer.Resource[Project, ProjectArgs, ProjectState](),
 			infer.Resource[Branch, BranchArgs, BranchState](),
@@ -24,26 +29,186 @@ func Provider() provider.Provider {
 			infer.Resource[Database, DatabaseArgs, DatabaseState](),
 			infer.Resource[Role, RoleArgs, RoleState](),
 		},
+		Components: []infer.InferredComponent{
+			infer.Component[PreviewDatabase, PreviewDatabaseArgs, *PreviewDatabaseState](),
+		},
+		Functions: []infer.InferredFunction{
+			infer.Function[GetProject, GetProjectArgs, GetProjectResult](),
+			infer.Function[GetBranch, GetBranchArgs, GetBranchResult](),
+			infer.Function[GetEndpoints, GetEndpointsArgs, GetEndpointsResult](),
+			infer.Function[GetRegions, GetRegionsArgs, GetRegionsResult](),
+			infer.Function[BranchResetToParent, BranchResetToParentArgs, BranchMethodResult](),
+			infer.Function[BranchRestore, BranchRestoreArgs, BranchMethodResult](),
+		},
 		ModuleMap: map[tokens.ModuleName]tokens.ModuleName{
 			"provider": "index",
 		},
-		Config: infer.Config[*Config](),
-	})
+		Config:   infer.Config[*Config](),
+		Metadata: metadata,
+	})))
+}
+
+// metadata describes the package in the schema that the SDKs are generated from.
+var metadata = schema.Metadata{
+	DisplayName: "Neon",
+	Description: "A Pulumi provider for managing Neon serverless Postgres projects, branches, endpoints, databases and roles.",
+	Keywords:    []string{"pulumi", "neon", "postgres", "category/database", "kind/native"},
+	Homepage:    "https://github.com/DonsWayo/pulumi-neon",
+	Repository:  "https://github.com/DonsWayo/pulumi-neon",
+	Publisher:   "DonsWayo",
+	License:     "Apache-2.0",
+	LanguageMap: map[string]any{
+		"go": map[string]any{
+			"importBasePath": "github.com/DonsWayo/pulumi-neon/sdk/go/neon",
+		},
+	},
+}
+
+// secretOutputs are the outputs of each resource that carry credentials.
+var secretOutputs = map[tokens.Type][]resource.PropertyKey{
+	"neon:index:Project":  {"connectionUri"},
+	"neon:index:Endpoint": {"connectionUri", "pooledConnectionUri"},
+	"neon:index:Role":     {"password"},
+}
+
+// withSecretReads marks credentials secret in the results of Read. infer only does so
+// on create and update, so imported resources would otherwise expose them.
+func withSecretReads(prov provider.Provider) provider.Provider {
+	read := prov.Read
+	prov.Read = func(ctx context.Context, req provider.ReadRequest) (provider.ReadResponse, error) {
+		resp, err := read(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+		for _, key := range secretOutputs[req.Urn.Type()] {
+			if v, ok := resp.Properties[key]; ok && !v.IsSecret() {
+				resp.Properties[key] = resource.MakeSecret(v)
+			}
+		}
+		return resp, nil
+	}
+	return prov
 }
 
+// Environment variables read when the matching configuration is not set.
+const (
+	apiKeyEnvVar = "NEON_API_KEY"
+	apiUrlEnvVar = "NEON_API_URL"
+)
+
+// Config is the provider configuration. It has no version field: Pulumi reserves the
+// version key for the plugin version, and a schema that declares it cannot generate SDKs.
 type Config struct {
-	ApiKey  string  `pulumi:"apiKey"`
-	Version *string `pulumi:"version,optional"`
+	ApiKey string  `pulumi:"apiKey,optional" provider:"secret"`
+	ApiUrl *string `pulumi:"apiUrl,optional"`
+	// OrgId is the organization that projects are created in when they do not set their
+	// own orgId. It is not needed with an org API key.
+	OrgId *string `pulumi:"orgId,optional"`
+	// RequestTimeoutSeconds bounds a single HTTP request to the API.
+	RequestTimeoutSeconds *int `pulumi:"requestTimeoutSeconds,optional"`
+	// MaxRetries is how many times a failed API call is retried.
+	MaxRetries *int `pulumi:"maxRetries,optional"`
+	// RetryTimeoutSeconds bounds how long a single API call keeps retrying while Neon
+	// reports the project as locked, rate limited or unavailable.
+	RetryTimeoutSeconds *int `pulumi:"retryTimeoutSeconds,optional"`
+	// UserAgentSuffix is appended to the User-Agent header, to tell apart requests
+	// from different pipelines.
+	UserAgentSuffix *string `pulumi:"userAgentSuffix,optional"`
+
+	client *Client
+}
+
+func (c *Config) Annotate(a infer.Annotator) {
+	a.Describe(&c.ApiKey, fmt.Sprintf("The Neon API key. Defaults to the %s environment variable.", apiKeyEnvVar))
+	a.Describe(&c.ApiUrl, fmt.Sprintf("The base URL of the Neon API. Defaults to the %s environment variable, then %s.", apiUrlEnvVar, defaultBaseURL))
+	a.Describe(&c.OrgId, "The organization that projects are created in when they do not set their own orgId.")
+	a.Describe(&c.RequestTimeoutSeconds, "How long a single request to the Neon API may take.")
+	a.Describe(&c.MaxRetries, "How many times a failed request to the Neon API is retried. Zero disables retries.")
+	a.Describe(&c.RetryTimeoutSeconds, "How long a request keeps retrying while Neon reports it as locked, rate limited or unavailable.")
+	a.Describe(&c.UserAgentSuffix, "Text appended to the User-Agent header of every request.")
 }
 
 func (c *Config) Validate() error {
 	if c.ApiKey == "" {
-		return fmt.Errorf("apiKey is required")
+		return fmt.Errorf("apiKey is required: set it in the provider configuration or in %s", apiKeyEnvVar)
+	}
+	if c.ApiUrl != nil {
+		if u, err := url.Parse(*c.ApiUrl); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("apiUrl: %q is not an http or https URL", *c.ApiUrl)
+		}
+	}
+	if c.OrgId != nil {
+		if reason := validOrgID(*c.OrgId); reason != "" {
+			return fmt.Errorf("orgId: %s", reason)
+		}
+	}
+	// A zero timeout would disable the HTTP client's timeout rather than bound requests.
+	if c.RequestTimeoutSeconds != nil && *c.RequestTimeoutSeconds <= 0 {
+		return fmt.Errorf("requestTimeoutSeconds must be positive")
+	}
+	for name, v := range map[string]*int{
+		"maxRetries":          c.MaxRetries,
+		"retryTimeoutSeconds": c.RetryTimeoutSeconds,
+	} {
+		if v != nil && *v < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
 	}
 	return nil
 }
 
-// IsNotFoundError checks if the error is a "not found" error
-func IsNotFoundError(err error) bool {
-	return err != nil && strings.Contains(err.Error(), "404 Not Found")
+// Configure builds the shared Neon API client used by every resource.
+func (c *Config) Configure(ctx context.Context) error {
+	if c.ApiKey == "" {
+		c.ApiKey = os.Getenv(apiKeyEnvVar)
+	}
+	if c.ApiUrl == nil || *c.ApiUrl == "" {
+		c.ApiUrl = nil
+		if apiUrl := os.Getenv(apiUrlEnvVar); apiUrl != "" {
+			c.ApiUrl = &apiUrl
+		}
+	}
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	opts := []ClientOption{WithUserAgent(userAgent(c.UserAgentSuffix))}
+	if c.ApiUrl != nil {
+		opts = append(opts, WithBaseURL(*c.ApiUrl))
+	}
+	if c.OrgId != nil {
+		opts = append(opts, WithOrgID(*c.OrgId))
+	}
+	if c.RequestTimeoutSeconds != nil {
+		opts = append(opts, WithTimeout(time.Duration(*c.RequestTimeoutSeconds)*time.Second))
+	}
+	if c.MaxRetries != nil {
+		opts = append(opts, WithMaxRetries(*c.MaxRetries))
+	}
+	if c.RetryTimeoutSeconds != nil {
+		opts = append(opts, WithRetryTimeout(time.Duration(*c.RetryTimeoutSeconds)*time.Second))
+	}
+	c.client = NewClient(c.ApiKey, opts...)
+	return nil
+}
+
+func userAgent(suffix *string) string {
+	version := Version
+	if version == "" {
+		version = "dev"
+	}
+	agent := fmt.Sprintf("pulumi-%s/%s", Name, version)
+	if suffix != nil && *suffix != "" {
+		agent += " " + *suffix
+	}
+	return agent
+}
+
+// getClient returns the Neon API client configured for this provider.
+func getClient(ctx context.Context) (*Client, error) {
+	config := infer.GetConfig[*Config](ctx)
+	if config == nil || config.client == nil {
+		return nil, fmt.Errorf("missing configuration")
+	}
+	return config.client, nil
 }
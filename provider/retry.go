@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultMaxRetries   = 8
+	defaultRetryTimeout = 5 * time.Minute
+	defaultMinBackoff   = 500 * time.Millisecond
+	defaultMaxBackoff   = 30 * time.Second
+)
+
+// isIdempotent reports whether a request with the given method can be sent again after
+// an ambiguous failure. Neon PATCH bodies carry absolute values, so replaying one leaves
+// the resource in the same state.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetry reports whether a failed request is worth sending again.
+//
+// A locked project or a rate limit means Neon refused the request without acting on it,
+// so those are retried for every method. Server errors may have been partially applied
+// and are only retried for idempotent methods.
+func shouldRetry(method string, err error) bool {
+	switch {
+	case IsLocked(err), IsRateLimited(err):
+		return true
+	case hasStatus(err, http.StatusInternalServerError), hasStatus(err, http.StatusBadGateway),
+		hasStatus(err, http.StatusServiceUnavailable), hasStatus(err, http.StatusGatewayTimeout):
+		return isIdempotent(method)
+	}
+	return false
+}
+
+// retryAfter parses the Retry-After header, which Neon may send as either a number of
+// seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		wait := time.Until(when)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// backoff returns the jittered delay before retry number attempt (starting at zero).
+func (c *Client) backoff(attempt int) time.Duration {
+	delay := c.maxBackoff
+	if attempt < 30 {
+		if d := c.minBackoff << attempt; d > 0 && d < c.maxBackoff {
+			delay = d
+		}
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// sleep waits for d or until ctx is done, whichever comes first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
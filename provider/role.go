@@ -1,257 +1,185 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
+	"log"
 	"net/http"
+	"reflect"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 )
 
 type Role struct{}
 
+func (r *Role) Annotate(a infer.Annotator) {
+	a.Describe(&r, "A Postgres role on a Neon branch. Import it with an ID of the form "+roleIDFormat+".")
+}
+
 type RoleArgs struct {
 	ProjectId string `pulumi:"projectId"`
 	BranchId  string `pulumi:"branchId"`
 	Name      string `pulumi:"name"`
+	// PasswordVersion is an arbitrary value; changing it resets the role's password.
+	PasswordVersion *string `pulumi:"passwordVersion,optional"`
+}
+
+func (args *RoleArgs) Annotate(a infer.Annotator) {
+	a.Describe(&args.ProjectId, "The ID of the project the role belongs to. Changing it replaces the role.")
+	a.Describe(&args.BranchId, "The ID of the branch the role is on. Changing it replaces the role.")
+	a.Describe(&args.Name, "The name of the role. Changing it replaces the role.")
+	a.Describe(&args.PasswordVersion, "An arbitrary value. Changing it resets the role's password.")
 }
 
 type RoleState struct {
 	RoleArgs
-	Id        string `pulumi:"id"`
+	// Password is the role's current password, as generated by Neon.
+	Password  string `pulumi:"password,optional" provider:"secret"`
 	CreatedAt string `pulumi:"createdAt"`
 }
 
-func (r Role) Create(ctx context.Context, name string, input RoleArgs, preview bool) (string, RoleState, error) {
-	if preview {
-		return name, RoleState{RoleArgs: input}, nil
-	}
-
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return "", RoleState{}, fmt.Errorf("missing configuration")
-	}
-
-	roleData := struct {
-		Name string `json:"name"`
-	}{
-		Name: input.Name,
-	}
+func (s *RoleState) Annotate(a infer.Annotator) {
+	a.Describe(&s.Password, "The role's current password, as generated by Neon.")
+	a.Describe(&s.CreatedAt, "When the role was created, in RFC 3339 format.")
+}
 
-	jsonData, err := json.Marshal(map[string]interface{}{"role": roleData})
-	if err != nil {
-		return "", RoleState{}, fmt.Errorf("failed to marshal role data: %v", err)
-	}
+// WireDependencies marks the password secret. A preview only reports it as unknown when
+// passwordVersion changes, since that is the only update that resets it.
+func (r Role) WireDependencies(f infer.FieldSelector, args *RoleArgs, state *RoleState) {
+	f.OutputField(&state.Password).AlwaysSecret()
+	f.OutputField(&state.Password).DependsOn(f.InputField(&args.PasswordVersion))
+}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/projects/%s/branches/%s/roles", baseURL, input.ProjectId, input.BranchId), bytes.NewBuffer(jsonData))
-	if err != nil {
-		return "", RoleState{}, fmt.Errorf("failed to create request: %v", err)
+// Check validates the role's inputs before anything is sent to Neon.
+func (r Role) Check(ctx context.Context, name string, oldInputs, newInputs resource.PropertyMap) (RoleArgs, []p.CheckFailure, error) {
+	args, failures, err := infer.DefaultCheck[RoleArgs](ctx, newInputs)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
+	c := newChecker(newInputs)
+	c.check("name", validRoleName)
+	return args, c.failures, nil
+}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", RoleState{}, fmt.Errorf("failed to send request: %v", err)
+func (r Role) Create(ctx context.Context, name string, input RoleArgs, preview bool) (string, RoleState, error) {
+	if preview {
+		return "", RoleState{RoleArgs: input}, nil
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	client, err := getClient(ctx)
 	if err != nil {
-		return "", RoleState{}, fmt.Errorf("failed to read response body: %v", err)
+		return "", RoleState{}, err
 	}
 
-	if resp.StatusCode != http.StatusCreated {
-		return "", RoleState{}, fmt.Errorf("failed to create role: %s", string(body))
+	role, err := client.CreateRole(ctx, input.ProjectId, input.BranchId, input.Name)
+	if err != nil && !IsOperationFailed(err) {
+		return "", RoleState{}, fmt.Errorf("failed to create role: %w", err)
 	}
+	role.PasswordVersion = input.PasswordVersion
 
-	var result struct {
-		Role struct {
-			Name      string `json:"name"`
-			Password  string `json:"password"`
-			Protected bool   `json:"protected"`
-			CreatedAt string `json:"created_at"`
-		} `json:"role"`
-	}
-
-	err = json.Unmarshal(body, &result)
+	id := resourceID(role.ProjectId, role.BranchId, role.Name)
 	if err != nil {
-		return "", RoleState{}, fmt.Errorf("failed to unmarshal response: %v", err)
+		return id, *role, initFailed(err)
 	}
-
-	return name, RoleState{
-		RoleArgs: RoleArgs{
-			ProjectId: input.ProjectId,
-			BranchId:  input.BranchId,
-			Name:      result.Role.Name,
-		},
-		Id:        result.Role.Name,
-		CreatedAt: result.Role.CreatedAt,
-	}, nil
+	return id, *role, nil
 }
 
 func (r Role) Read(ctx context.Context, id string, inputs RoleArgs, state RoleState) (string, RoleArgs, RoleState, error) {
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return "", RoleArgs{}, RoleState{}, fmt.Errorf("missing configuration")
-	}
-
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/projects/%s/branches/%s/roles/%s", baseURL, state.ProjectId, state.BranchId, state.Name), nil)
+	client, err := getClient(ctx)
 	if err != nil {
-		return "", RoleArgs{}, RoleState{}, fmt.Errorf("failed to create request: %v", err)
+		return "", RoleArgs{}, RoleState{}, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", RoleArgs{}, RoleState{}, fmt.Errorf("failed to send request: %v", err)
+	projectId, branchId, roleName := state.ProjectId, state.BranchId, state.Name
+	if projectId == "" || branchId == "" || roleName == "" {
+		parts, err := parseResourceID(id, roleIDFormat)
+		if err != nil {
+			return "", RoleArgs{}, RoleState{}, err
+		}
+		projectId, branchId, roleName = parts[0], parts[1], parts[2]
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	role, err := client.GetRole(ctx, projectId, branchId, roleName)
 	if err != nil {
-		return "", RoleArgs{}, RoleState{}, fmt.Errorf("failed to read response body: %v", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		if IsNotFoundError(fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))) {
+		if IsNotFound(err) {
 			return "", RoleArgs{}, RoleState{}, nil
 		}
-		return "", RoleArgs{}, RoleState{}, fmt.Errorf("failed to read role: %s", string(body))
-	}
-
-	var result struct {
-		Role struct {
-			Name      string `json:"name"`
-			Protected bool   `json:"protected"`
-			CreatedAt string `json:"created_at"`
-		} `json:"role"`
-	}
+		return "", RoleArgs{}, RoleState{}, fmt.Errorf("failed to read role: %w", err)
+	}
+
+	// Neon only returns the password when it is generated, so it is revealed to catch
+	// resets made outside of Pulumi and to fill in imported roles. Projects that do not
+	// store passwords cannot reveal them, in which case the password in state, if any, is
+	// kept.
+	role.PasswordVersion = state.PasswordVersion
+	role.Password = state.Password
+	password, err := client.RevealRolePassword(ctx, projectId, branchId, role.Name)
+	switch {
+	case err == nil:
+		role.Password = password
+	case passwordNotStored(err):
+		log.Printf("Could not reveal the password of role %s: %v", role.Name, err)
+	default:
+		return "", RoleArgs{}, RoleState{}, fmt.Errorf("failed to reveal role password: %w", err)
+	}
+
+	return id, role.RoleArgs, *role, nil
+}
 
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return "", RoleArgs{}, RoleState{}, fmt.Errorf("failed to unmarshal response: %v", err)
-	}
+// passwordNotStored reports whether err is how Neon refuses to reveal a password that the
+// project does not store.
+func passwordNotStored(err error) bool {
+	return hasStatus(err, http.StatusBadRequest) || hasStatus(err, http.StatusNotFound)
+}
 
-	return id, RoleArgs{
-		ProjectId: state.ProjectId,
-		BranchId:  state.BranchId,
-		Name:      result.Role.Name,
-	}, RoleState{
-		RoleArgs: RoleArgs{
-			ProjectId: state.ProjectId,
-			BranchId:  state.BranchId,
-			Name:      result.Role.Name,
-		},
-		Id:        result.Role.Name,
-		CreatedAt: result.Role.CreatedAt,
-	}, nil
+// Diff compares the program's inputs with the last known state of the role. Neon cannot
+// rename roles, so a new name replaces the role. Changing the password version rotates
+// the password in place.
+func (r Role) Diff(ctx context.Context, id string, olds RoleState, news RoleArgs) (p.DiffResponse, error) {
+	diff := diffBuilder{}
+	diff.replace("projectId", olds.ProjectId != news.ProjectId)
+	diff.replace("branchId", olds.BranchId != news.BranchId)
+	diff.replace("name", olds.Name != news.Name)
+	diff.update("passwordVersion", !reflect.DeepEqual(olds.PasswordVersion, news.PasswordVersion))
+
+	return diff.response(), nil
 }
 
 func (r Role) Update(ctx context.Context, id string, olds RoleState, news RoleArgs, preview bool) (RoleState, error) {
-	if preview {
-		return RoleState{
-			RoleArgs:  news,
-			Id:        olds.Id,
-			CreatedAt: olds.CreatedAt,
-		}, nil
-	}
-
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return RoleState{}, fmt.Errorf("missing configuration")
+	state := olds
+	state.PasswordVersion = news.PasswordVersion
+	if preview || reflect.DeepEqual(olds.PasswordVersion, news.PasswordVersion) {
+		return state, nil
 	}
 
-	roleData := struct {
-		Name string `json:"name"`
-	}{
-		Name: news.Name,
-	}
-
-	jsonData, err := json.Marshal(map[string]interface{}{"role": roleData})
-	if err != nil {
-		return RoleState{}, fmt.Errorf("failed to marshal role data: %v", err)
-	}
-
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/projects/%s/branches/%s/roles/%s", baseURL, news.ProjectId, news.BranchId, olds.Name), bytes.NewBuffer(jsonData))
+	client, err := getClient(ctx)
 	if err != nil {
-		return RoleState{}, fmt.Errorf("failed to create request: %v", err)
+		return RoleState{}, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return RoleState{}, fmt.Errorf("failed to send request: %v", err)
+	password, err := client.ResetRolePassword(ctx, olds.ProjectId, olds.BranchId, olds.Name)
+	if err != nil && !IsOperationFailed(err) {
+		return RoleState{}, fmt.Errorf("failed to reset role password: %w", err)
 	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+	state.Password = password
 	if err != nil {
-		return RoleState{}, fmt.Errorf("failed to read response body: %v", err)
+		return state, initFailed(err)
 	}
-
-	if resp.StatusCode != http.StatusOK {
-		return RoleState{}, fmt.Errorf("failed to update role: %s", string(body))
-	}
-
-	var result struct {
-		Role struct {
-			Name      string `json:"name"`
-			Protected bool   `json:"protected"`
-			CreatedAt string `json:"created_at"`
-		} `json:"role"`
-	}
-
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return RoleState{}, fmt.Errorf("failed to unmarshal response: %v", err)
-	}
-
-	return RoleState{
-		RoleArgs: RoleArgs{
-			ProjectId: news.ProjectId,
-			BranchId:  news.BranchId,
-			Name:      result.Role.Name,
-		},
-		Id:        result.Role.Name,
-		CreatedAt: result.Role.CreatedAt,
-	}, nil
+	return state, nil
 }
 
 func (r Role) Delete(ctx context.Context, id string, state RoleState) error {
-	config := infer.GetConfig[*Config](ctx)
-	if config == nil {
-		return fmt.Errorf("missing configuration")
-	}
-
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/projects/%s/branches/%s/roles/%s", baseURL, state.ProjectId, state.BranchId, state.Name), nil)
+	client, err := getClient(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %v", err)
+		return err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+config.ApiKey)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to send request: %v", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete role: %s", string(body))
+	if err := client.DeleteRole(ctx, state.ProjectId, state.BranchId, state.Name); err != nil && !IsNotFound(err) {
+		return fmt.Errorf("failed to delete role: %w", err)
 	}
 
 	return nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,329 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon
+{
+    /// <summary>
+    /// A branch of a Neon project. Import it with an ID of the form projectId/branchId.
+    /// </summary>
+    [NeonResourceType("neon:index:Branch")]
+    public partial class Branch : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The ID Neon assigned to the branch.
+        /// </summary>
+        [Output("branchId")]
+        public Output<string> BranchId { get; private set; } = null!;
+
+        /// <summary>
+        /// When the branch was created, in RFC 3339 format.
+        /// </summary>
+        [Output("createdAt")]
+        public Output<string> CreatedAt { get; private set; } = null!;
+
+        /// <summary>
+        /// The endpoints created from endpoints, in the same order. They are deleted along with the branch.
+        /// </summary>
+        [Output("createdEndpoints")]
+        public Output<ImmutableArray<Outputs.CreatedEndpoint>> CreatedEndpoints { get; private set; } = null!;
+
+        /// <summary>
+        /// Compute endpoints to create together with the branch. Changing them replaces the branch.
+        /// </summary>
+        [Output("endpoints")]
+        public Output<ImmutableArray<Outputs.BranchEndpoint>> Endpoints { get; private set; } = null!;
+
+        /// <summary>
+        /// The RFC 3339 time at which Neon deletes the branch. Only one of expiresAt and ttl may be set.
+        /// </summary>
+        [Output("expiresAt")]
+        public Output<string?> ExpiresAt { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the branch.
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the branch to branch from. Defaults to the project's default branch. Changing it replaces the branch.
+        /// </summary>
+        [Output("parentId")]
+        public Output<string?> ParentId { get; private set; } = null!;
+
+        /// <summary>
+        /// Branch from the parent as of this Log Sequence Number. Changing it replaces the branch.
+        /// </summary>
+        [Output("parentLsn")]
+        public Output<string?> ParentLsn { get; private set; } = null!;
+
+        /// <summary>
+        /// Branch from the parent as of this RFC 3339 point in time. Changing it replaces the branch.
+        /// </summary>
+        [Output("parentTimestamp")]
+        public Output<string?> ParentTimestamp { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the project the branch belongs to. Changing it replaces the branch.
+        /// </summary>
+        [Output("projectId")]
+        public Output<string> ProjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// How long after its creation Neon deletes the branch, as a duration such as 72h. Only one of expiresAt and ttl may be set.
+        /// </summary>
+        [Output("ttl")]
+        public Output<string?> Ttl { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Branch resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Branch(string name, BranchArgs args, CustomResourceOptions? options = null)
+            : base("neon:index:Branch", name, args ?? new BranchArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Branch(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("neon:index:Branch", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Branch resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Branch Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Branch(name, id, options);
+        }
+
+        /// <summary>
+        /// Reset the branch to the latest state of its parent, discarding its own changes.
+        /// </summary>
+        public global::Pulumi.Output<BranchResetToParentResult> ResetToParent()
+            => global::Pulumi.Deployment.Instance.Call<BranchResetToParentResult>("neon:index:Branch/resetToParent", CallArgs.Empty, this);
+
+        /// <summary>
+        /// Restore the branch's data from a source branch, as of its head, an LSN or a point in time.
+        /// </summary>
+        public global::Pulumi.Output<BranchRestoreResult> Restore(BranchRestoreArgs args)
+            => global::Pulumi.Deployment.Instance.Call<BranchRestoreResult>("neon:index:Branch/restore", args ?? new BranchRestoreArgs(), this);
+    }
+
+    public sealed class BranchArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("endpoints")]
+        private InputList<Inputs.BranchEndpointArgs>? _endpoints;
+
+        /// <summary>
+        /// Compute endpoints to create together with the branch. Changing them replaces the branch.
+        /// </summary>
+        public InputList<Inputs.BranchEndpointArgs> Endpoints
+        {
+            get => _endpoints ?? (_endpoints = new InputList<Inputs.BranchEndpointArgs>());
+            set => _endpoints = value;
+        }
+
+        /// <summary>
+        /// The RFC 3339 time at which Neon deletes the branch. Only one of expiresAt and ttl may be set.
+        /// </summary>
+        [Input("expiresAt")]
+        public Input<string>? ExpiresAt { get; set; }
+
+        /// <summary>
+        /// The name of the branch.
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// The ID of the branch to branch from. Defaults to the project's default branch. Changing it replaces the branch.
+        /// </summary>
+        [Input("parentId")]
+        public Input<string>? ParentId { get; set; }
+
+        /// <summary>
+        /// Branch from the parent as of this Log Sequence Number. Changing it replaces the branch.
+        /// </summary>
+        [Input("parentLsn")]
+        public Input<string>? ParentLsn { get; set; }
+
+        /// <summary>
+        /// Branch from the parent as of this RFC 3339 point in time. Changing it replaces the branch.
+        /// </summary>
+        [Input("parentTimestamp")]
+        public Input<string>? ParentTimestamp { get; set; }
+
+        /// <summary>
+        /// The ID of the project the branch belongs to. Changing it replaces the branch.
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        /// <summary>
+        /// How long after its creation Neon deletes the branch, as a duration such as 72h. Only one of expiresAt and ttl may be set.
+        /// </summary>
+        [Input("ttl")]
+        public Input<string>? Ttl { get; set; }
+
+        public BranchArgs()
+        {
+        }
+        public static new BranchArgs Empty => new BranchArgs();
+    }
+
+    /// <summary>
+    /// The results of the <see cref="Branch.ResetToParent"/> method.
+    /// </summary>
+    [OutputType]
+    public sealed class BranchResetToParentResult
+    {
+        /// <summary>
+        /// The ID of the branch.
+        /// </summary>
+        public readonly string BranchId;
+        /// <summary>
+        /// The name of the branch.
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The ID of the branch's parent.
+        /// </summary>
+        public readonly string? ParentId;
+        /// <summary>
+        /// The Log Sequence Number of the parent the branch now starts from.
+        /// </summary>
+        public readonly string? ParentLsn;
+        /// <summary>
+        /// The point in time of the parent the branch now starts from.
+        /// </summary>
+        public readonly string? ParentTimestamp;
+
+        [OutputConstructor]
+        private BranchResetToParentResult(
+            string branchId,
+
+            string name,
+
+            string? parentId,
+
+            string? parentLsn,
+
+            string? parentTimestamp)
+        {
+            BranchId = branchId;
+            Name = name;
+            ParentId = parentId;
+            ParentLsn = parentLsn;
+            ParentTimestamp = parentTimestamp;
+        }
+    }
+
+    /// <summary>
+    /// The set of arguments for the <see cref="Branch.Restore"/> method.
+    /// </summary>
+    public sealed class BranchRestoreArgs : global::Pulumi.CallArgs
+    {
+        /// <summary>
+        /// Restore the source as of this Log Sequence Number. Only one of lsn and timestamp may be set.
+        /// </summary>
+        [Input("lsn")]
+        public Input<string>? Lsn { get; set; }
+
+        /// <summary>
+        /// Save the branch's data from before the restore as a new branch with this name.
+        /// </summary>
+        [Input("preserveUnderName")]
+        public Input<string>? PreserveUnderName { get; set; }
+
+        /// <summary>
+        /// The ID of the branch to restore from.
+        /// </summary>
+        [Input("sourceBranchId", required: true)]
+        public Input<string> SourceBranchId { get; set; } = null!;
+
+        /// <summary>
+        /// Restore the source as of this RFC 3339 point in time. Only one of lsn and timestamp may be set.
+        /// </summary>
+        [Input("timestamp")]
+        public Input<string>? Timestamp { get; set; }
+
+        public BranchRestoreArgs()
+        {
+        }
+        public static new BranchRestoreArgs Empty => new BranchRestoreArgs();
+    }
+
+    /// <summary>
+    /// The results of the <see cref="Branch.Restore"/> method.
+    /// </summary>
+    [OutputType]
+    public sealed class BranchRestoreResult
+    {
+        /// <summary>
+        /// The ID of the branch.
+        /// </summary>
+        public readonly string BranchId;
+        /// <summary>
+        /// The name of the branch.
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The ID of the branch's parent.
+        /// </summary>
+        public readonly string? ParentId;
+        /// <summary>
+        /// The Log Sequence Number of the parent the branch now starts from.
+        /// </summary>
+        public readonly string? ParentLsn;
+        /// <summary>
+        /// The point in time of the parent the branch now starts from.
+        /// </summary>
+        public readonly string? ParentTimestamp;
+
+        [OutputConstructor]
+        private BranchRestoreResult(
+            string branchId,
+
+            string name,
+
+            string? parentId,
+
+            string? parentLsn,
+
+            string? parentTimestamp)
+        {
+            BranchId = branchId;
+            Name = name;
+            ParentId = parentId;
+            ParentLsn = parentLsn;
+            ParentTimestamp = parentTimestamp;
+        }
+    }
+}
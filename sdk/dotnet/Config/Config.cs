@@ -0,0 +1,106 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Immutable;
+
+namespace Pulumi.Neon
+{
+    public static class Config
+    {
+        [global::System.Diagnostics.CodeAnalysis.SuppressMessage("Microsoft.Design", "IDE1006", Justification = 
+        "Double underscore prefix used to avoid conflicts with variable names.")]
+        private sealed class __Value<T>
+        {
+            private readonly Func<T> _getter;
+            private T _value = default!;
+            private bool _set;
+
+            public __Value(Func<T> getter)
+            {
+                _getter = getter;
+            }
+
+            public T Get() => _set ? _value : _getter();
+
+            public void Set(T value)
+            {
+                _value = value;
+                _set = true;
+            }
+        }
+
+        private static readonly global::Pulumi.Config __config = new global::Pulumi.Config("neon");
+
+        private static readonly __Value<string?> _apiKey = new __Value<string?>(() => __config.Get("apiKey"));
+        /// <summary>
+        /// The Neon API key. Defaults to the NEON_API_KEY environment variable.
+        /// </summary>
+        public static string? ApiKey
+        {
+            get => _apiKey.Get();
+            set => _apiKey.Set(value);
+        }
+
+        private static readonly __Value<string?> _apiUrl = new __Value<string?>(() => __config.Get("apiUrl"));
+        /// <summary>
+        /// The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+        /// </summary>
+        public static string? ApiUrl
+        {
+            get => _apiUrl.Get();
+            set => _apiUrl.Set(value);
+        }
+
+        private static readonly __Value<int?> _maxRetries = new __Value<int?>(() => __config.GetInt32("maxRetries"));
+        /// <summary>
+        /// How many times a failed request to the Neon API is retried. Zero disables retries.
+        /// </summary>
+        public static int? MaxRetries
+        {
+            get => _maxRetries.Get();
+            set => _maxRetries.Set(value);
+        }
+
+        private static readonly __Value<string?> _orgId = new __Value<string?>(() => __config.Get("orgId"));
+        /// <summary>
+        /// The organization that projects are created in when they do not set their own orgId.
+        /// </summary>
+        public static string? OrgId
+        {
+            get => _orgId.Get();
+            set => _orgId.Set(value);
+        }
+
+        private static readonly __Value<int?> _requestTimeoutSeconds = new __Value<int?>(() => __config.GetInt32("requestTimeoutSeconds"));
+        /// <summary>
+        /// How long a single request to the Neon API may take.
+        /// </summary>
+        public static int? RequestTimeoutSeconds
+        {
+            get => _requestTimeoutSeconds.Get();
+            set => _requestTimeoutSeconds.Set(value);
+        }
+
+        private static readonly __Value<int?> _retryTimeoutSeconds = new __Value<int?>(() => __config.GetInt32("retryTimeoutSeconds"));
+        /// <summary>
+        /// How long a request keeps retrying while Neon reports it as locked, rate limited or unavailable.
+        /// </summary>
+        public static int? RetryTimeoutSeconds
+        {
+            get => _retryTimeoutSeconds.Get();
+            set => _retryTimeoutSeconds.Set(value);
+        }
+
+        private static readonly __Value<string?> _userAgentSuffix = new __Value<string?>(() => __config.Get("userAgentSuffix"));
+        /// <summary>
+        /// Text appended to the User-Agent header of every request.
+        /// </summary>
+        public static string? UserAgentSuffix
+        {
+            get => _userAgentSuffix.Get();
+            set => _userAgentSuffix.Set(value);
+        }
+
+    }
+}
@@ -0,0 +1,128 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon
+{
+    /// <summary>
+    /// A Postgres database on a Neon branch. Import it with an ID of the form projectId/branchId/databaseId, where the database's name may be given in place of its ID.
+    /// </summary>
+    [NeonResourceType("neon:index:Database")]
+    public partial class Database : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The ID of the branch the database is on. Changing it replaces the database.
+        /// </summary>
+        [Output("branchId")]
+        public Output<string> BranchId { get; private set; } = null!;
+
+        /// <summary>
+        /// When the database was created, in RFC 3339 format.
+        /// </summary>
+        [Output("createdAt")]
+        public Output<string> CreatedAt { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID Neon assigned to the database.
+        /// </summary>
+        [Output("databaseId")]
+        public Output<string> DatabaseId { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the database.
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// The role that owns the database, typically the name of a Role resource.
+        /// </summary>
+        [Output("ownerName")]
+        public Output<string> OwnerName { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the project the database belongs to. Changing it replaces the database.
+        /// </summary>
+        [Output("projectId")]
+        public Output<string> ProjectId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Database resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Database(string name, DatabaseArgs args, CustomResourceOptions? options = null)
+            : base("neon:index:Database", name, args ?? new DatabaseArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Database(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("neon:index:Database", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Database resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Database Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Database(name, id, options);
+        }
+    }
+
+    public sealed class DatabaseArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The ID of the branch the database is on. Changing it replaces the database.
+        /// </summary>
+        [Input("branchId", required: true)]
+        public Input<string> BranchId { get; set; } = null!;
+
+        /// <summary>
+        /// The name of the database.
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// The role that owns the database, typically the name of a Role resource.
+        /// </summary>
+        [Input("ownerName", required: true)]
+        public Input<string> OwnerName { get; set; } = null!;
+
+        /// <summary>
+        /// The ID of the project the database belongs to. Changing it replaces the database.
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        public DatabaseArgs()
+        {
+        }
+        public static new DatabaseArgs Empty => new DatabaseArgs();
+    }
+}
@@ -0,0 +1,295 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon
+{
+    /// <summary>
+    /// A compute endpoint on a Neon branch. Import it with an ID of the form projectId/endpointId.
+    /// </summary>
+    [NeonResourceType("neon:index:Endpoint")]
+    public partial class Endpoint : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The maximum compute size, in compute units.
+        /// </summary>
+        [Output("autoscalingLimitMaxCu")]
+        public Output<double?> AutoscalingLimitMaxCu { get; private set; } = null!;
+
+        /// <summary>
+        /// The minimum compute size, in compute units.
+        /// </summary>
+        [Output("autoscalingLimitMinCu")]
+        public Output<double?> AutoscalingLimitMinCu { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the branch the endpoint serves. Changing it moves the endpoint to that branch.
+        /// </summary>
+        [Output("branchId")]
+        public Output<string> BranchId { get; private set; } = null!;
+
+        /// <summary>
+        /// A connection URI for databaseName as roleName. It embeds the role's password.
+        /// </summary>
+        [Output("connectionUri")]
+        public Output<string?> ConnectionUri { get; private set; } = null!;
+
+        /// <summary>
+        /// When the endpoint was created, in RFC 3339 format.
+        /// </summary>
+        [Output("createdAt")]
+        public Output<string> CreatedAt { get; private set; } = null!;
+
+        /// <summary>
+        /// The state of the endpoint's compute, such as active or idle.
+        /// </summary>
+        [Output("currentState")]
+        public Output<string?> CurrentState { get; private set; } = null!;
+
+        /// <summary>
+        /// The database the connection URIs connect to. The URIs are only filled in when roleName is set too.
+        /// </summary>
+        [Output("databaseName")]
+        public Output<string?> DatabaseName { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID Neon assigned to the endpoint.
+        /// </summary>
+        [Output("endpointId")]
+        public Output<string> EndpointId { get; private set; } = null!;
+
+        /// <summary>
+        /// The hostname of the endpoint.
+        /// </summary>
+        [Output("host")]
+        public Output<string> Host { get; private set; } = null!;
+
+        /// <summary>
+        /// When the endpoint was last active, in RFC 3339 format.
+        /// </summary>
+        [Output("lastActive")]
+        public Output<string?> LastActive { get; private set; } = null!;
+
+        /// <summary>
+        /// A connection URI for databaseName as roleName that goes through the connection pooler. It embeds the role's password.
+        /// </summary>
+        [Output("pooledConnectionUri")]
+        public Output<string?> PooledConnectionUri { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether connections can go through the PgBouncer connection pooler.
+        /// </summary>
+        [Output("poolerEnabled")]
+        public Output<bool?> PoolerEnabled { get; private set; } = null!;
+
+        /// <summary>
+        /// The hostname that routes connections through PgBouncer.
+        /// </summary>
+        [Output("poolerHost")]
+        public Output<string?> PoolerHost { get; private set; } = null!;
+
+        /// <summary>
+        /// The connection pooler mode, such as transaction.
+        /// </summary>
+        [Output("poolerMode")]
+        public Output<string?> PoolerMode { get; private set; } = null!;
+
+        /// <summary>
+        /// The port the endpoint accepts connections on.
+        /// </summary>
+        [Output("port")]
+        public Output<int?> Port { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the project the endpoint belongs to. Changing it replaces the endpoint.
+        /// </summary>
+        [Output("projectId")]
+        public Output<string> ProjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// The compute provisioner, k8s-pod or k8s-neonvm.
+        /// </summary>
+        [Output("provisioner")]
+        public Output<string?> Provisioner { get; private set; } = null!;
+
+        /// <summary>
+        /// The hostname of the proxy in front of the endpoint.
+        /// </summary>
+        [Output("proxyHost")]
+        public Output<string?> ProxyHost { get; private set; } = null!;
+
+        /// <summary>
+        /// The region of the endpoint, which must match the project's region. Changing it replaces the endpoint.
+        /// </summary>
+        [Output("regionId")]
+        public Output<string?> RegionId { get; private set; } = null!;
+
+        /// <summary>
+        /// The role whose credentials the connection URIs use. The URIs are only filled in when databaseName is set too.
+        /// </summary>
+        [Output("roleName")]
+        public Output<string?> RoleName { get; private set; } = null!;
+
+        /// <summary>
+        /// Postgres settings applied to the endpoint's compute.
+        /// </summary>
+        [Output("settings")]
+        public Output<ImmutableDictionary<string, string>?> Settings { get; private set; } = null!;
+
+        /// <summary>
+        /// How long, in seconds, an idle endpoint keeps running before it is suspended.
+        /// </summary>
+        [Output("suspendTimeoutSeconds")]
+        public Output<int?> SuspendTimeoutSeconds { get; private set; } = null!;
+
+        /// <summary>
+        /// The endpoint type, read_write or read_only. Changing it replaces the endpoint.
+        /// </summary>
+        [Output("type")]
+        public Output<string> Type { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Endpoint resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Endpoint(string name, EndpointArgs args, CustomResourceOptions? options = null)
+            : base("neon:index:Endpoint", name, args ?? new EndpointArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Endpoint(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("neon:index:Endpoint", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "connectionUri",
+                    "pooledConnectionUri",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Endpoint resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Endpoint Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Endpoint(name, id, options);
+        }
+    }
+
+    public sealed class EndpointArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The maximum compute size, in compute units.
+        /// </summary>
+        [Input("autoscalingLimitMaxCu")]
+        public Input<double>? AutoscalingLimitMaxCu { get; set; }
+
+        /// <summary>
+        /// The minimum compute size, in compute units.
+        /// </summary>
+        [Input("autoscalingLimitMinCu")]
+        public Input<double>? AutoscalingLimitMinCu { get; set; }
+
+        /// <summary>
+        /// The ID of the branch the endpoint serves. Changing it moves the endpoint to that branch.
+        /// </summary>
+        [Input("branchId", required: true)]
+        public Input<string> BranchId { get; set; } = null!;
+
+        /// <summary>
+        /// The database the connection URIs connect to. The URIs are only filled in when roleName is set too.
+        /// </summary>
+        [Input("databaseName")]
+        public Input<string>? DatabaseName { get; set; }
+
+        /// <summary>
+        /// Whether connections can go through the PgBouncer connection pooler.
+        /// </summary>
+        [Input("poolerEnabled")]
+        public Input<bool>? PoolerEnabled { get; set; }
+
+        /// <summary>
+        /// The connection pooler mode, such as transaction.
+        /// </summary>
+        [Input("poolerMode")]
+        public Input<string>? PoolerMode { get; set; }
+
+        /// <summary>
+        /// The ID of the project the endpoint belongs to. Changing it replaces the endpoint.
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        /// <summary>
+        /// The compute provisioner, k8s-pod or k8s-neonvm.
+        /// </summary>
+        [Input("provisioner")]
+        public Input<string>? Provisioner { get; set; }
+
+        /// <summary>
+        /// The region of the endpoint, which must match the project's region. Changing it replaces the endpoint.
+        /// </summary>
+        [Input("regionId")]
+        public Input<string>? RegionId { get; set; }
+
+        /// <summary>
+        /// The role whose credentials the connection URIs use. The URIs are only filled in when databaseName is set too.
+        /// </summary>
+        [Input("roleName")]
+        public Input<string>? RoleName { get; set; }
+
+        [Input("settings")]
+        private InputMap<string>? _settings;
+
+        /// <summary>
+        /// Postgres settings applied to the endpoint's compute.
+        /// </summary>
+        public InputMap<string> Settings
+        {
+            get => _settings ?? (_settings = new InputMap<string>());
+            set => _settings = value;
+        }
+
+        /// <summary>
+        /// How long, in seconds, an idle endpoint keeps running before it is suspended.
+        /// </summary>
+        [Input("suspendTimeoutSeconds")]
+        public Input<int>? SuspendTimeoutSeconds { get; set; }
+
+        /// <summary>
+        /// The endpoint type, read_write or read_only. Changing it replaces the endpoint.
+        /// </summary>
+        [Input("type", required: true)]
+        public Input<string> Type { get; set; } = null!;
+
+        public EndpointArgs()
+        {
+        }
+        public static new EndpointArgs Empty => new EndpointArgs();
+    }
+}
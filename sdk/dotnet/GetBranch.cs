@@ -0,0 +1,126 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon
+{
+    public static class GetBranch
+    {
+        /// <summary>
+        /// Look up a branch of a Neon project by its name.
+        /// </summary>
+        public static Task<GetBranchResult> InvokeAsync(GetBranchArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetBranchResult>("neon:index:getBranch", args ?? new GetBranchArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Look up a branch of a Neon project by its name.
+        /// </summary>
+        public static Output<GetBranchResult> Invoke(GetBranchInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetBranchResult>("neon:index:getBranch", args ?? new GetBranchInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetBranchArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The name of the branch to look up.
+        /// </summary>
+        [Input("name", required: true)]
+        public string Name { get; set; } = null!;
+
+        /// <summary>
+        /// The ID of the project the branch belongs to.
+        /// </summary>
+        [Input("projectId", required: true)]
+        public string ProjectId { get; set; } = null!;
+
+        public GetBranchArgs()
+        {
+        }
+        public static new GetBranchArgs Empty => new GetBranchArgs();
+    }
+
+    public sealed class GetBranchInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The name of the branch to look up.
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// The ID of the project the branch belongs to.
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        public GetBranchInvokeArgs()
+        {
+        }
+        public static new GetBranchInvokeArgs Empty => new GetBranchInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetBranchResult
+    {
+        /// <summary>
+        /// The ID of the branch.
+        /// </summary>
+        public readonly string BranchId;
+        /// <summary>
+        /// When the branch was created, in RFC 3339 format.
+        /// </summary>
+        public readonly string CreatedAt;
+        /// <summary>
+        /// The name of the branch.
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The ID of the branch it was branched from.
+        /// </summary>
+        public readonly string? ParentId;
+        /// <summary>
+        /// The Log Sequence Number of the parent it was branched from.
+        /// </summary>
+        public readonly string? ParentLsn;
+        /// <summary>
+        /// The point in time of the parent it was branched from.
+        /// </summary>
+        public readonly string? ParentTimestamp;
+        /// <summary>
+        /// The ID of the project the branch belongs to.
+        /// </summary>
+        public readonly string ProjectId;
+
+        [OutputConstructor]
+        private GetBranchResult(
+            string branchId,
+
+            string createdAt,
+
+            string name,
+
+            string? parentId,
+
+            string? parentLsn,
+
+            string? parentTimestamp,
+
+            string projectId)
+        {
+            BranchId = branchId;
+            CreatedAt = createdAt;
+            Name = name;
+            ParentId = parentId;
+            ParentLsn = parentLsn;
+            ParentTimestamp = parentTimestamp;
+            ProjectId = projectId;
+        }
+    }
+}
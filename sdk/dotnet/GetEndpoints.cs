@@ -0,0 +1,83 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon
+{
+    public static class GetEndpoints
+    {
+        /// <summary>
+        /// List the compute endpoints on a branch of a Neon project.
+        /// </summary>
+        public static Task<GetEndpointsResult> InvokeAsync(GetEndpointsArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetEndpointsResult>("neon:index:getEndpoints", args ?? new GetEndpointsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// List the compute endpoints on a branch of a Neon project.
+        /// </summary>
+        public static Output<GetEndpointsResult> Invoke(GetEndpointsInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetEndpointsResult>("neon:index:getEndpoints", args ?? new GetEndpointsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetEndpointsArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The ID of the branch whose endpoints are listed.
+        /// </summary>
+        [Input("branchId", required: true)]
+        public string BranchId { get; set; } = null!;
+
+        /// <summary>
+        /// The ID of the project the branch belongs to.
+        /// </summary>
+        [Input("projectId", required: true)]
+        public string ProjectId { get; set; } = null!;
+
+        public GetEndpointsArgs()
+        {
+        }
+        public static new GetEndpointsArgs Empty => new GetEndpointsArgs();
+    }
+
+    public sealed class GetEndpointsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The ID of the branch whose endpoints are listed.
+        /// </summary>
+        [Input("branchId", required: true)]
+        public Input<string> BranchId { get; set; } = null!;
+
+        /// <summary>
+        /// The ID of the project the branch belongs to.
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        public GetEndpointsInvokeArgs()
+        {
+        }
+        public static new GetEndpointsInvokeArgs Empty => new GetEndpointsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetEndpointsResult
+    {
+        /// <summary>
+        /// The endpoints on the branch.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.EndpointSummary> Endpoints;
+
+        [OutputConstructor]
+        private GetEndpointsResult(ImmutableArray<Outputs.EndpointSummary> endpoints)
+        {
+            Endpoints = endpoints;
+        }
+    }
+}
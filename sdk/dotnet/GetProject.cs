@@ -0,0 +1,159 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon
+{
+    public static class GetProject
+    {
+        /// <summary>
+        /// Look up a Neon project by its ID or, within an organization, by its name.
+        /// </summary>
+        public static Task<GetProjectResult> InvokeAsync(GetProjectArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetProjectResult>("neon:index:getProject", args ?? new GetProjectArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Look up a Neon project by its ID or, within an organization, by its name.
+        /// </summary>
+        public static Output<GetProjectResult> Invoke(GetProjectInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetProjectResult>("neon:index:getProject", args ?? new GetProjectInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetProjectArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The name of the project to look up. Exactly one of projectId and name must be set.
+        /// </summary>
+        [Input("name")]
+        public string? Name { get; set; }
+
+        /// <summary>
+        /// The organization searched for a project by name. Defaults to the provider's orgId.
+        /// </summary>
+        [Input("orgId")]
+        public string? OrgId { get; set; }
+
+        /// <summary>
+        /// The ID of the project to look up. Exactly one of projectId and name must be set.
+        /// </summary>
+        [Input("projectId")]
+        public string? ProjectId { get; set; }
+
+        public GetProjectArgs()
+        {
+        }
+        public static new GetProjectArgs Empty => new GetProjectArgs();
+    }
+
+    public sealed class GetProjectInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The name of the project to look up. Exactly one of projectId and name must be set.
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// The organization searched for a project by name. Defaults to the provider's orgId.
+        /// </summary>
+        [Input("orgId")]
+        public Input<string>? OrgId { get; set; }
+
+        /// <summary>
+        /// The ID of the project to look up. Exactly one of projectId and name must be set.
+        /// </summary>
+        [Input("projectId")]
+        public Input<string>? ProjectId { get; set; }
+
+        public GetProjectInvokeArgs()
+        {
+        }
+        public static new GetProjectInvokeArgs Empty => new GetProjectInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetProjectResult
+    {
+        /// <summary>
+        /// When the project was created, in RFC 3339 format.
+        /// </summary>
+        public readonly string CreatedAt;
+        /// <summary>
+        /// The compute settings applied to endpoints created in the project.
+        /// </summary>
+        public readonly Outputs.DefaultEndpointSettings? DefaultEndpointSettings;
+        /// <summary>
+        /// How long, in seconds, Neon keeps the history that branches can be created from.
+        /// </summary>
+        public readonly int? HistoryRetentionSeconds;
+        /// <summary>
+        /// The name of the project.
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+        /// </summary>
+        public readonly string? OrgId;
+        /// <summary>
+        /// The major Postgres version. Changing it replaces the project.
+        /// </summary>
+        public readonly int? PgVersion;
+        /// <summary>
+        /// The ID of the project.
+        /// </summary>
+        public readonly string ProjectId;
+        /// <summary>
+        /// The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+        /// </summary>
+        public readonly string? Provisioner;
+        /// <summary>
+        /// The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+        /// </summary>
+        public readonly string RegionId;
+        /// <summary>
+        /// Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+        /// </summary>
+        public readonly bool? StorePasswords;
+
+        [OutputConstructor]
+        private GetProjectResult(
+            string createdAt,
+
+            Outputs.DefaultEndpointSettings? defaultEndpointSettings,
+
+            int? historyRetentionSeconds,
+
+            string name,
+
+            string? orgId,
+
+            int? pgVersion,
+
+            string projectId,
+
+            string? provisioner,
+
+            string regionId,
+
+            bool? storePasswords)
+        {
+            CreatedAt = createdAt;
+            DefaultEndpointSettings = defaultEndpointSettings;
+            HistoryRetentionSeconds = historyRetentionSeconds;
+            Name = name;
+            OrgId = orgId;
+            PgVersion = pgVersion;
+            ProjectId = projectId;
+            Provisioner = provisioner;
+            RegionId = regionId;
+            StorePasswords = storePasswords;
+        }
+    }
+}
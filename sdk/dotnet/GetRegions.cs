@@ -0,0 +1,51 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon
+{
+    public static class GetRegions
+    {
+        /// <summary>
+        /// List the regions Neon can create projects in.
+        /// </summary>
+        public static Task<GetRegionsResult> InvokeAsync(GetRegionsArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetRegionsResult>("neon:index:getRegions", args ?? new GetRegionsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// List the regions Neon can create projects in.
+        /// </summary>
+        public static Output<GetRegionsResult> Invoke(InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetRegionsResult>("neon:index:getRegions", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    public sealed class GetRegionsArgs : global::Pulumi.InvokeArgs
+    {
+        public GetRegionsArgs()
+        {
+        }
+        public static new GetRegionsArgs Empty => new GetRegionsArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetRegionsResult
+    {
+        /// <summary>
+        /// The regions Neon can create projects in.
+        /// </summary>
+        public readonly ImmutableArray<Outputs.Region> Regions;
+
+        [OutputConstructor]
+        private GetRegionsResult(ImmutableArray<Outputs.Region> regions)
+        {
+            Regions = regions;
+        }
+    }
+}
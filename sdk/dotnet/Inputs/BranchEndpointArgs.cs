@@ -0,0 +1,53 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon.Inputs
+{
+
+    /// <summary>
+    /// A compute endpoint created along with its branch.
+    /// </summary>
+    public sealed class BranchEndpointArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The maximum compute size, in compute units.
+        /// </summary>
+        [Input("autoscalingLimitMaxCu")]
+        public Input<double>? AutoscalingLimitMaxCu { get; set; }
+
+        /// <summary>
+        /// The minimum compute size, in compute units.
+        /// </summary>
+        [Input("autoscalingLimitMinCu")]
+        public Input<double>? AutoscalingLimitMinCu { get; set; }
+
+        /// <summary>
+        /// The compute provisioner, k8s-pod or k8s-neonvm.
+        /// </summary>
+        [Input("provisioner")]
+        public Input<string>? Provisioner { get; set; }
+
+        /// <summary>
+        /// How long, in seconds, an idle endpoint keeps running before it is suspended.
+        /// </summary>
+        [Input("suspendTimeoutSeconds")]
+        public Input<int>? SuspendTimeoutSeconds { get; set; }
+
+        /// <summary>
+        /// The endpoint type, read_write or read_only.
+        /// </summary>
+        [Input("type", required: true)]
+        public Input<string> Type { get; set; } = null!;
+
+        public BranchEndpointArgs()
+        {
+        }
+        public static new BranchEndpointArgs Empty => new BranchEndpointArgs();
+    }
+}
@@ -0,0 +1,41 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon.Inputs
+{
+
+    /// <summary>
+    /// Compute settings applied to the endpoints created in a project.
+    /// </summary>
+    public sealed class DefaultEndpointSettingsArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The maximum compute size, in compute units.
+        /// </summary>
+        [Input("autoscalingLimitMaxCu")]
+        public Input<double>? AutoscalingLimitMaxCu { get; set; }
+
+        /// <summary>
+        /// The minimum compute size, in compute units.
+        /// </summary>
+        [Input("autoscalingLimitMinCu")]
+        public Input<double>? AutoscalingLimitMinCu { get; set; }
+
+        /// <summary>
+        /// How long, in seconds, an idle endpoint keeps running before it is suspended.
+        /// </summary>
+        [Input("suspendTimeoutSeconds")]
+        public Input<int>? SuspendTimeoutSeconds { get; set; }
+
+        public DefaultEndpointSettingsArgs()
+        {
+        }
+        public static new DefaultEndpointSettingsArgs Empty => new DefaultEndpointSettingsArgs();
+    }
+}
@@ -0,0 +1,59 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon.Outputs
+{
+
+    /// <summary>
+    /// A compute endpoint created along with its branch.
+    /// </summary>
+    [OutputType]
+    public sealed class BranchEndpoint
+    {
+        /// <summary>
+        /// The maximum compute size, in compute units.
+        /// </summary>
+        public readonly double? AutoscalingLimitMaxCu;
+        /// <summary>
+        /// The minimum compute size, in compute units.
+        /// </summary>
+        public readonly double? AutoscalingLimitMinCu;
+        /// <summary>
+        /// The compute provisioner, k8s-pod or k8s-neonvm.
+        /// </summary>
+        public readonly string? Provisioner;
+        /// <summary>
+        /// How long, in seconds, an idle endpoint keeps running before it is suspended.
+        /// </summary>
+        public readonly int? SuspendTimeoutSeconds;
+        /// <summary>
+        /// The endpoint type, read_write or read_only.
+        /// </summary>
+        public readonly string Type;
+
+        [OutputConstructor]
+        private BranchEndpoint(
+            double? autoscalingLimitMaxCu,
+
+            double? autoscalingLimitMinCu,
+
+            string? provisioner,
+
+            int? suspendTimeoutSeconds,
+
+            string type)
+        {
+            AutoscalingLimitMaxCu = autoscalingLimitMaxCu;
+            AutoscalingLimitMinCu = autoscalingLimitMinCu;
+            Provisioner = provisioner;
+            SuspendTimeoutSeconds = suspendTimeoutSeconds;
+            Type = type;
+        }
+    }
+}
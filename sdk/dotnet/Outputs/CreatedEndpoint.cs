@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon.Outputs
+{
+
+    /// <summary>
+    /// An endpoint created along with its branch.
+    /// </summary>
+    [OutputType]
+    public sealed class CreatedEndpoint
+    {
+        /// <summary>
+        /// The ID Neon assigned to the endpoint.
+        /// </summary>
+        public readonly string EndpointId;
+        /// <summary>
+        /// The hostname of the endpoint.
+        /// </summary>
+        public readonly string Host;
+        /// <summary>
+        /// The endpoint type, read_write or read_only.
+        /// </summary>
+        public readonly string Type;
+
+        [OutputConstructor]
+        private CreatedEndpoint(
+            string endpointId,
+
+            string host,
+
+            string type)
+        {
+            EndpointId = endpointId;
+            Host = host;
+            Type = type;
+        }
+    }
+}
@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon.Outputs
+{
+
+    /// <summary>
+    /// Compute settings applied to the endpoints created in a project.
+    /// </summary>
+    [OutputType]
+    public sealed class DefaultEndpointSettings
+    {
+        /// <summary>
+        /// The maximum compute size, in compute units.
+        /// </summary>
+        public readonly double? AutoscalingLimitMaxCu;
+        /// <summary>
+        /// The minimum compute size, in compute units.
+        /// </summary>
+        public readonly double? AutoscalingLimitMinCu;
+        /// <summary>
+        /// How long, in seconds, an idle endpoint keeps running before it is suspended.
+        /// </summary>
+        public readonly int? SuspendTimeoutSeconds;
+
+        [OutputConstructor]
+        private DefaultEndpointSettings(
+            double? autoscalingLimitMaxCu,
+
+            double? autoscalingLimitMinCu,
+
+            int? suspendTimeoutSeconds)
+        {
+            AutoscalingLimitMaxCu = autoscalingLimitMaxCu;
+            AutoscalingLimitMinCu = autoscalingLimitMinCu;
+            SuspendTimeoutSeconds = suspendTimeoutSeconds;
+        }
+    }
+}
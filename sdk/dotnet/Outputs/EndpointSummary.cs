@@ -0,0 +1,101 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon.Outputs
+{
+
+    /// <summary>
+    /// A compute endpoint found by getEndpoints.
+    /// </summary>
+    [OutputType]
+    public sealed class EndpointSummary
+    {
+        /// <summary>
+        /// The maximum compute size, in compute units.
+        /// </summary>
+        public readonly double AutoscalingLimitMaxCu;
+        /// <summary>
+        /// The minimum compute size, in compute units.
+        /// </summary>
+        public readonly double AutoscalingLimitMinCu;
+        /// <summary>
+        /// When the endpoint was created, in RFC 3339 format.
+        /// </summary>
+        public readonly string CreatedAt;
+        /// <summary>
+        /// The state of the endpoint's compute, such as active or idle.
+        /// </summary>
+        public readonly string? CurrentState;
+        /// <summary>
+        /// The ID of the endpoint.
+        /// </summary>
+        public readonly string EndpointId;
+        /// <summary>
+        /// The hostname of the endpoint.
+        /// </summary>
+        public readonly string Host;
+        /// <summary>
+        /// The hostname that routes connections through PgBouncer.
+        /// </summary>
+        public readonly string? PoolerHost;
+        /// <summary>
+        /// The port the endpoint accepts connections on.
+        /// </summary>
+        public readonly int Port;
+        /// <summary>
+        /// The region of the endpoint.
+        /// </summary>
+        public readonly string? RegionId;
+        /// <summary>
+        /// How long, in seconds, an idle endpoint keeps running before it is suspended.
+        /// </summary>
+        public readonly int SuspendTimeoutSeconds;
+        /// <summary>
+        /// The endpoint type, read_write or read_only.
+        /// </summary>
+        public readonly string Type;
+
+        [OutputConstructor]
+        private EndpointSummary(
+            double autoscalingLimitMaxCu,
+
+            double autoscalingLimitMinCu,
+
+            string createdAt,
+
+            string? currentState,
+
+            string endpointId,
+
+            string host,
+
+            string? poolerHost,
+
+            int port,
+
+            string? regionId,
+
+            int suspendTimeoutSeconds,
+
+            string type)
+        {
+            AutoscalingLimitMaxCu = autoscalingLimitMaxCu;
+            AutoscalingLimitMinCu = autoscalingLimitMinCu;
+            CreatedAt = createdAt;
+            CurrentState = currentState;
+            EndpointId = endpointId;
+            Host = host;
+            PoolerHost = poolerHost;
+            Port = port;
+            RegionId = regionId;
+            SuspendTimeoutSeconds = suspendTimeoutSeconds;
+            Type = type;
+        }
+    }
+}
@@ -0,0 +1,45 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon.Outputs
+{
+
+    /// <summary>
+    /// A region Neon can create projects in.
+    /// </summary>
+    [OutputType]
+    public sealed class Region
+    {
+        /// <summary>
+        /// Whether projects are created in this region when they do not set one.
+        /// </summary>
+        public readonly bool Default;
+        /// <summary>
+        /// The human readable name of the region.
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The ID of the region, such as aws-us-east-2.
+        /// </summary>
+        public readonly string RegionId;
+
+        [OutputConstructor]
+        private Region(
+            bool @default,
+
+            string name,
+
+            string regionId)
+        {
+            Default = @default;
+            Name = name;
+            RegionId = regionId;
+        }
+    }
+}
@@ -0,0 +1,146 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon
+{
+    /// <summary>
+    /// A branch of a Neon project with its own compute endpoint, role and database, for short-lived preview environments.
+    /// </summary>
+    [NeonResourceType("neon:index:PreviewDatabase")]
+    public partial class PreviewDatabase : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// The ID of the preview's branch.
+        /// </summary>
+        [Output("branchId")]
+        public Output<string> BranchId { get; private set; } = null!;
+
+        /// <summary>
+        /// When the preview's branch was created, in RFC 3339 format.
+        /// </summary>
+        [Output("createdAt")]
+        public Output<string> CreatedAt { get; private set; } = null!;
+
+        /// <summary>
+        /// The database created on the branch.
+        /// </summary>
+        [Output("databaseName")]
+        public Output<string> DatabaseName { get; private set; } = null!;
+
+        /// <summary>
+        /// A connection URI for the database as the role.
+        /// </summary>
+        [Output("dsn")]
+        public Output<string> Dsn { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the preview's read-write endpoint.
+        /// </summary>
+        [Output("endpointId")]
+        public Output<string> EndpointId { get; private set; } = null!;
+
+        /// <summary>
+        /// When the preview's ttl runs out, in RFC 3339 format.
+        /// </summary>
+        [Output("expiresAt")]
+        public Output<string?> ExpiresAt { get; private set; } = null!;
+
+        /// <summary>
+        /// The hostname of the preview's endpoint.
+        /// </summary>
+        [Output("host")]
+        public Output<string> Host { get; private set; } = null!;
+
+        /// <summary>
+        /// A connection URI for the database as the role that goes through the connection pooler.
+        /// </summary>
+        [Output("pooledDsn")]
+        public Output<string> PooledDsn { get; private set; } = null!;
+
+        /// <summary>
+        /// The role created on the branch.
+        /// </summary>
+        [Output("roleName")]
+        public Output<string> RoleName { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a PreviewDatabase resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public PreviewDatabase(string name, PreviewDatabaseArgs args, ComponentResourceOptions? options = null)
+            : base("neon:index:PreviewDatabase", name, args ?? new PreviewDatabaseArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "dsn",
+                    "pooledDsn",
+                },
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class PreviewDatabaseArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The database created on the branch. It must not already exist on the parent branch.
+        /// </summary>
+        [Input("databaseName")]
+        public Input<string>? DatabaseName { get; set; }
+
+        /// <summary>
+        /// The name of the preview's branch.
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// The branch the preview is copied from. Defaults to the project's default branch.
+        /// </summary>
+        [Input("parentBranchId")]
+        public Input<string>? ParentBranchId { get; set; }
+
+        /// <summary>
+        /// The ID of the project to create the preview in.
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        /// <summary>
+        /// The role created on the branch. It must not already exist on the parent branch.
+        /// </summary>
+        [Input("roleName")]
+        public Input<string>? RoleName { get; set; }
+
+        /// <summary>
+        /// How long the preview lives, as a duration such as 72h. Neon deletes the branch once it expires.
+        /// </summary>
+        [Input("ttl")]
+        public Input<string>? Ttl { get; set; }
+
+        public PreviewDatabaseArgs()
+        {
+        }
+        public static new PreviewDatabaseArgs Empty => new PreviewDatabaseArgs();
+    }
+}
@@ -0,0 +1,210 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon
+{
+    /// <summary>
+    /// A Neon project. Import it with its project ID.
+    /// </summary>
+    [NeonResourceType("neon:index:Project")]
+    public partial class Project : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// A connection URI for the default database as the default role. It embeds the role's password.
+        /// </summary>
+        [Output("connectionUri")]
+        public Output<string?> ConnectionUri { get; private set; } = null!;
+
+        /// <summary>
+        /// When the project was created, in RFC 3339 format.
+        /// </summary>
+        [Output("createdAt")]
+        public Output<string> CreatedAt { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the branch Neon created along with the project.
+        /// </summary>
+        [Output("defaultBranchId")]
+        public Output<string?> DefaultBranchId { get; private set; } = null!;
+
+        /// <summary>
+        /// The database Neon created along with the project.
+        /// </summary>
+        [Output("defaultDatabaseName")]
+        public Output<string?> DefaultDatabaseName { get; private set; } = null!;
+
+        /// <summary>
+        /// The hostname of the read-write endpoint on the default branch.
+        /// </summary>
+        [Output("defaultEndpointHost")]
+        public Output<string?> DefaultEndpointHost { get; private set; } = null!;
+
+        /// <summary>
+        /// The compute settings applied to endpoints created in the project.
+        /// </summary>
+        [Output("defaultEndpointSettings")]
+        public Output<Outputs.DefaultEndpointSettings?> DefaultEndpointSettings { get; private set; } = null!;
+
+        /// <summary>
+        /// The role Neon created along with the project. It owns the default database.
+        /// </summary>
+        [Output("defaultRoleName")]
+        public Output<string?> DefaultRoleName { get; private set; } = null!;
+
+        /// <summary>
+        /// How long, in seconds, Neon keeps the history that branches can be created from.
+        /// </summary>
+        [Output("historyRetentionSeconds")]
+        public Output<int?> HistoryRetentionSeconds { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the project.
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+        /// </summary>
+        [Output("orgId")]
+        public Output<string?> OrgId { get; private set; } = null!;
+
+        /// <summary>
+        /// The major Postgres version. Changing it replaces the project.
+        /// </summary>
+        [Output("pgVersion")]
+        public Output<int?> PgVersion { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID Neon assigned to the project.
+        /// </summary>
+        [Output("projectId")]
+        public Output<string> ProjectId { get; private set; } = null!;
+
+        /// <summary>
+        /// The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+        /// </summary>
+        [Output("provisioner")]
+        public Output<string?> Provisioner { get; private set; } = null!;
+
+        /// <summary>
+        /// The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+        /// </summary>
+        [Output("regionId")]
+        public Output<string> RegionId { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+        /// </summary>
+        [Output("storePasswords")]
+        public Output<bool?> StorePasswords { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Project resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Project(string name, ProjectArgs args, CustomResourceOptions? options = null)
+            : base("neon:index:Project", name, args ?? new ProjectArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Project(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("neon:index:Project", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "connectionUri",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Project resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Project Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Project(name, id, options);
+        }
+    }
+
+    public sealed class ProjectArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The compute settings applied to endpoints created in the project.
+        /// </summary>
+        [Input("defaultEndpointSettings")]
+        public Input<Inputs.DefaultEndpointSettingsArgs>? DefaultEndpointSettings { get; set; }
+
+        /// <summary>
+        /// How long, in seconds, Neon keeps the history that branches can be created from.
+        /// </summary>
+        [Input("historyRetentionSeconds")]
+        public Input<int>? HistoryRetentionSeconds { get; set; }
+
+        /// <summary>
+        /// The name of the project.
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+        /// </summary>
+        [Input("orgId")]
+        public Input<string>? OrgId { get; set; }
+
+        /// <summary>
+        /// The major Postgres version. Changing it replaces the project.
+        /// </summary>
+        [Input("pgVersion")]
+        public Input<int>? PgVersion { get; set; }
+
+        /// <summary>
+        /// The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+        /// </summary>
+        [Input("provisioner")]
+        public Input<string>? Provisioner { get; set; }
+
+        /// <summary>
+        /// The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+        /// </summary>
+        [Input("regionId", required: true)]
+        public Input<string> RegionId { get; set; } = null!;
+
+        /// <summary>
+        /// Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+        /// </summary>
+        [Input("storePasswords")]
+        public Input<bool>? StorePasswords { get; set; }
+
+        public ProjectArgs()
+        {
+        }
+        public static new ProjectArgs Empty => new ProjectArgs();
+    }
+}
@@ -1,4 +1,4 @@
-// *** WARNING: this file was generated by pulumi. ***
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 using System;
@@ -7,11 +7,36 @@ using System.Collections.Immutable;
 using System.Threading.Tasks;
 using Pulumi.Serialization;
 
-namespace Pulumi.Xyz
+namespace Pulumi.Neon
 {
-    [XyzResourceType("pulumi:providers:xyz")]
+    [NeonResourceType("pulumi:providers:neon")]
     public partial class Provider : global::Pulumi.ProviderResource
     {
+        /// <summary>
+        /// The Neon API key. Defaults to the NEON_API_KEY environment variable.
+        /// </summary>
+        [Output("apiKey")]
+        public Output<string?> ApiKey { get; private set; } = null!;
+
+        /// <summary>
+        /// The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+        /// </summary>
+        [Output("apiUrl")]
+        public Output<string?> ApiUrl { get; private set; } = null!;
+
+        /// <summary>
+        /// The organization that projects are created in when they do not set their own orgId.
+        /// </summary>
+        [Output("orgId")]
+        public Output<string?> OrgId { get; private set; } = null!;
+
+        /// <summary>
+        /// Text appended to the User-Agent header of every request.
+        /// </summary>
+        [Output("userAgentSuffix")]
+        public Output<string?> UserAgentSuffix { get; private set; } = null!;
+
+
         /// <summary>
         /// Create a Provider resource with the given unique name, arguments, and options.
         /// </summary>
@@ -20,7 +45,7 @@ namespace Pulumi.Xyz
         /// <param name="args">The arguments used to populate this resource's properties</param>
         /// <param name="options">A bag of options that control this resource's behavior</param>
         public Provider(string name, ProviderArgs? args = null, CustomResourceOptions? options = null)
-            : base("xyz", name, args ?? new ProviderArgs(), MakeResourceOptions(options, ""))
+            : base("neon", name, args ?? new ProviderArgs(), MakeResourceOptions(options, ""))
         {
         }
 
@@ -29,6 +54,10 @@ namespace Pulumi.Xyz
             var defaultOptions = new CustomResourceOptions
             {
                 Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "apiKey",
+                },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
             // Override the ID if one was specified for consistency with other language SDKs.
@@ -39,6 +68,58 @@ namespace Pulumi.Xyz
 
     public sealed class ProviderArgs : global::Pulumi.ResourceArgs
     {
+        [Input("apiKey")]
+        private Input<string>? _apiKey;
+
+        /// <summary>
+        /// The Neon API key. Defaults to the NEON_API_KEY environment variable.
+        /// </summary>
+        public Input<string>? ApiKey
+        {
+            get => _apiKey;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _apiKey = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+        /// </summary>
+        [Input("apiUrl")]
+        public Input<string>? ApiUrl { get; set; }
+
+        /// <summary>
+        /// How many times a failed request to the Neon API is retried. Zero disables retries.
+        /// </summary>
+        [Input("maxRetries", json: true)]
+        public Input<int>? MaxRetries { get; set; }
+
+        /// <summary>
+        /// The organization that projects are created in when they do not set their own orgId.
+        /// </summary>
+        [Input("orgId")]
+        public Input<string>? OrgId { get; set; }
+
+        /// <summary>
+        /// How long a single request to the Neon API may take.
+        /// </summary>
+        [Input("requestTimeoutSeconds", json: true)]
+        public Input<int>? RequestTimeoutSeconds { get; set; }
+
+        /// <summary>
+        /// How long a request keeps retrying while Neon reports it as locked, rate limited or unavailable.
+        /// </summary>
+        [Input("retryTimeoutSeconds", json: true)]
+        public Input<int>? RetryTimeoutSeconds { get; set; }
+
+        /// <summary>
+        /// Text appended to the User-Agent header of every request.
+        /// </summary>
+        [Input("userAgentSuffix")]
+        public Input<string>? UserAgentSuffix { get; set; }
+
         public ProviderArgs()
         {
         }
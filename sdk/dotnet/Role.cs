@@ -0,0 +1,132 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Neon
+{
+    /// <summary>
+    /// A Postgres role on a Neon branch. Import it with an ID of the form projectId/branchId/roleName.
+    /// </summary>
+    [NeonResourceType("neon:index:Role")]
+    public partial class Role : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The ID of the branch the role is on. Changing it replaces the role.
+        /// </summary>
+        [Output("branchId")]
+        public Output<string> BranchId { get; private set; } = null!;
+
+        /// <summary>
+        /// When the role was created, in RFC 3339 format.
+        /// </summary>
+        [Output("createdAt")]
+        public Output<string> CreatedAt { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the role. Changing it replaces the role.
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// The role's current password, as generated by Neon.
+        /// </summary>
+        [Output("password")]
+        public Output<string?> Password { get; private set; } = null!;
+
+        /// <summary>
+        /// An arbitrary value. Changing it resets the role's password.
+        /// </summary>
+        [Output("passwordVersion")]
+        public Output<string?> PasswordVersion { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID of the project the role belongs to. Changing it replaces the role.
+        /// </summary>
+        [Output("projectId")]
+        public Output<string> ProjectId { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Role resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Role(string name, RoleArgs args, CustomResourceOptions? options = null)
+            : base("neon:index:Role", name, args ?? new RoleArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Role(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("neon:index:Role", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "password",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Role resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Role Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Role(name, id, options);
+        }
+    }
+
+    public sealed class RoleArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The ID of the branch the role is on. Changing it replaces the role.
+        /// </summary>
+        [Input("branchId", required: true)]
+        public Input<string> BranchId { get; set; } = null!;
+
+        /// <summary>
+        /// The name of the role. Changing it replaces the role.
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// An arbitrary value. Changing it resets the role's password.
+        /// </summary>
+        [Input("passwordVersion")]
+        public Input<string>? PasswordVersion { get; set; }
+
+        /// <summary>
+        /// The ID of the project the role belongs to. Changing it replaces the role.
+        /// </summary>
+        [Input("projectId", required: true)]
+        public Input<string> ProjectId { get; set; } = null!;
+
+        public RoleArgs()
+        {
+        }
+        public static new RoleArgs Empty => new RoleArgs();
+    }
+}
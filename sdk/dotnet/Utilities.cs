@@ -1,7 +1,7 @@
-// *** WARNING: this file was generated by pulumi. ***
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
-namespace Pulumi.Xyz
+namespace Pulumi.Neon
 {
     static class Utilities
     {
@@ -62,7 +62,7 @@ namespace Pulumi.Xyz
         static Utilities()
         {
             var assembly = global::System.Reflection.IntrospectionExtensions.GetTypeInfo(typeof(Utilities)).Assembly;
-            using var stream = assembly.GetManifestResourceStream("Pulumi.Xyz.version.txt");
+            using var stream = assembly.GetManifestResourceStream("Pulumi.Neon.version.txt");
             using var reader = new global::System.IO.StreamReader(stream ?? throw new global::System.NotSupportedException("Missing embedded version.txt file"));
             version = reader.ReadToEnd().Trim();
             var parts = version.Split("\n");
@@ -74,9 +74,9 @@ namespace Pulumi.Xyz
         }
     }
 
-    internal sealed class XyzResourceTypeAttribute : global::Pulumi.ResourceTypeAttribute
+    internal sealed class NeonResourceTypeAttribute : global::Pulumi.ResourceTypeAttribute
     {
-        public XyzResourceTypeAttribute(string type) : base(type, Utilities.Version)
+        public NeonResourceTypeAttribute(string type) : base(type, Utilities.Version)
         {
         }
     }
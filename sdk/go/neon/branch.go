@@ -0,0 +1,359 @@
+// Code generated by pulumi-gen-neon DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package neon
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/DonsWayo/pulumi-neon/sdk/go/neon/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// A branch of a Neon project. Import it with an ID of the form projectId/branchId.
+type Branch struct {
+	pulumi.CustomResourceState
+
+	// The ID Neon assigned to the branch.
+	BranchId pulumi.StringOutput `pulumi:"branchId"`
+	// When the branch was created, in RFC 3339 format.
+	CreatedAt pulumi.StringOutput `pulumi:"createdAt"`
+	// The endpoints created from endpoints, in the same order. They are deleted along with the branch.
+	CreatedEndpoints CreatedEndpointArrayOutput `pulumi:"createdEndpoints"`
+	// Compute endpoints to create together with the branch. Changing them replaces the branch.
+	Endpoints BranchEndpointArrayOutput `pulumi:"endpoints"`
+	// The RFC 3339 time at which Neon deletes the branch. Only one of expiresAt and ttl may be set.
+	ExpiresAt pulumi.StringPtrOutput `pulumi:"expiresAt"`
+	// The name of the branch.
+	Name pulumi.StringOutput `pulumi:"name"`
+	// The ID of the branch to branch from. Defaults to the project's default branch. Changing it replaces the branch.
+	ParentId pulumi.StringPtrOutput `pulumi:"parentId"`
+	// Branch from the parent as of this Log Sequence Number. Changing it replaces the branch.
+	ParentLsn pulumi.StringPtrOutput `pulumi:"parentLsn"`
+	// Branch from the parent as of this RFC 3339 point in time. Changing it replaces the branch.
+	ParentTimestamp pulumi.StringPtrOutput `pulumi:"parentTimestamp"`
+	// The ID of the project the branch belongs to. Changing it replaces the branch.
+	ProjectId pulumi.StringOutput `pulumi:"projectId"`
+	// How long after its creation Neon deletes the branch, as a duration such as 72h. Only one of expiresAt and ttl may be set.
+	Ttl pulumi.StringPtrOutput `pulumi:"ttl"`
+}
+
+// NewBranch registers a new resource with the given unique name, arguments, and options.
+func NewBranch(ctx *pulumi.Context,
+	name string, args *BranchArgs, opts ...pulumi.ResourceOption) (*Branch, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Name == nil {
+		return nil, errors.New("invalid value for required argument 'Name'")
+	}
+	if args.ProjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ProjectId'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource Branch
+	err := ctx.RegisterResource("neon:index:Branch", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetBranch gets an existing Branch resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetBranch(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *BranchState, opts ...pulumi.ResourceOption) (*Branch, error) {
+	var resource Branch
+	err := ctx.ReadResource("neon:index:Branch", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Branch resources.
+type branchState struct {
+}
+
+type BranchState struct {
+}
+
+func (BranchState) ElementType() reflect.Type {
+	return reflect.TypeOf((*branchState)(nil)).Elem()
+}
+
+type branchArgs struct {
+	// Compute endpoints to create together with the branch. Changing them replaces the branch.
+	Endpoints []BranchEndpoint `pulumi:"endpoints"`
+	// The RFC 3339 time at which Neon deletes the branch. Only one of expiresAt and ttl may be set.
+	ExpiresAt *string `pulumi:"expiresAt"`
+	// The name of the branch.
+	Name string `pulumi:"name"`
+	// The ID of the branch to branch from. Defaults to the project's default branch. Changing it replaces the branch.
+	ParentId *string `pulumi:"parentId"`
+	// Branch from the parent as of this Log Sequence Number. Changing it replaces the branch.
+	ParentLsn *string `pulumi:"parentLsn"`
+	// Branch from the parent as of this RFC 3339 point in time. Changing it replaces the branch.
+	ParentTimestamp *string `pulumi:"parentTimestamp"`
+	// The ID of the project the branch belongs to. Changing it replaces the branch.
+	ProjectId string `pulumi:"projectId"`
+	// How long after its creation Neon deletes the branch, as a duration such as 72h. Only one of expiresAt and ttl may be set.
+	Ttl *string `pulumi:"ttl"`
+}
+
+// The set of arguments for constructing a Branch resource.
+type BranchArgs struct {
+	// Compute endpoints to create together with the branch. Changing them replaces the branch.
+	Endpoints BranchEndpointArrayInput
+	// The RFC 3339 time at which Neon deletes the branch. Only one of expiresAt and ttl may be set.
+	ExpiresAt pulumi.StringPtrInput
+	// The name of the branch.
+	Name pulumi.StringInput
+	// The ID of the branch to branch from. Defaults to the project's default branch. Changing it replaces the branch.
+	ParentId pulumi.StringPtrInput
+	// Branch from the parent as of this Log Sequence Number. Changing it replaces the branch.
+	ParentLsn pulumi.StringPtrInput
+	// Branch from the parent as of this RFC 3339 point in time. Changing it replaces the branch.
+	ParentTimestamp pulumi.StringPtrInput
+	// The ID of the project the branch belongs to. Changing it replaces the branch.
+	ProjectId pulumi.StringInput
+	// How long after its creation Neon deletes the branch, as a duration such as 72h. Only one of expiresAt and ttl may be set.
+	Ttl pulumi.StringPtrInput
+}
+
+func (BranchArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*branchArgs)(nil)).Elem()
+}
+
+// Reset the branch to the latest state of its parent, discarding its own changes.
+func (r *Branch) ResetToParent(ctx *pulumi.Context) (BranchResetToParentResultOutput, error) {
+	out, err := ctx.Call("neon:index:Branch/resetToParent", nil, BranchResetToParentResultOutput{}, r)
+	if err != nil {
+		return BranchResetToParentResultOutput{}, err
+	}
+	return out.(BranchResetToParentResultOutput), nil
+}
+
+// The branch after a method has changed it.
+type BranchResetToParentResult struct {
+	// The ID of the branch.
+	BranchId string `pulumi:"branchId"`
+	// The name of the branch.
+	Name string `pulumi:"name"`
+	// The ID of the branch's parent.
+	ParentId *string `pulumi:"parentId"`
+	// The Log Sequence Number of the parent the branch now starts from.
+	ParentLsn *string `pulumi:"parentLsn"`
+	// The point in time of the parent the branch now starts from.
+	ParentTimestamp *string `pulumi:"parentTimestamp"`
+}
+
+type BranchResetToParentResultOutput struct{ *pulumi.OutputState }
+
+func (BranchResetToParentResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BranchResetToParentResult)(nil)).Elem()
+}
+
+// The ID of the branch.
+func (o BranchResetToParentResultOutput) BranchId() pulumi.StringOutput {
+	return o.ApplyT(func(v BranchResetToParentResult) string { return v.BranchId }).(pulumi.StringOutput)
+}
+
+// The name of the branch.
+func (o BranchResetToParentResultOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v BranchResetToParentResult) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The ID of the branch's parent.
+func (o BranchResetToParentResultOutput) ParentId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BranchResetToParentResult) *string { return v.ParentId }).(pulumi.StringPtrOutput)
+}
+
+// The Log Sequence Number of the parent the branch now starts from.
+func (o BranchResetToParentResultOutput) ParentLsn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BranchResetToParentResult) *string { return v.ParentLsn }).(pulumi.StringPtrOutput)
+}
+
+// The point in time of the parent the branch now starts from.
+func (o BranchResetToParentResultOutput) ParentTimestamp() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BranchResetToParentResult) *string { return v.ParentTimestamp }).(pulumi.StringPtrOutput)
+}
+
+// Restore the branch's data from a source branch, as of its head, an LSN or a point in time.
+func (r *Branch) Restore(ctx *pulumi.Context, args *BranchRestoreArgs) (BranchRestoreResultOutput, error) {
+	out, err := ctx.Call("neon:index:Branch/restore", args, BranchRestoreResultOutput{}, r)
+	if err != nil {
+		return BranchRestoreResultOutput{}, err
+	}
+	return out.(BranchRestoreResultOutput), nil
+}
+
+type branchRestoreArgs struct {
+	// Restore the source as of this Log Sequence Number. Only one of lsn and timestamp may be set.
+	Lsn *string `pulumi:"lsn"`
+	// Save the branch's data from before the restore as a new branch with this name.
+	PreserveUnderName *string `pulumi:"preserveUnderName"`
+	// The ID of the branch to restore from.
+	SourceBranchId string `pulumi:"sourceBranchId"`
+	// Restore the source as of this RFC 3339 point in time. Only one of lsn and timestamp may be set.
+	Timestamp *string `pulumi:"timestamp"`
+}
+
+// The set of arguments for the Restore method of the Branch resource.
+type BranchRestoreArgs struct {
+	// Restore the source as of this Log Sequence Number. Only one of lsn and timestamp may be set.
+	Lsn pulumi.StringPtrInput
+	// Save the branch's data from before the restore as a new branch with this name.
+	PreserveUnderName pulumi.StringPtrInput
+	// The ID of the branch to restore from.
+	SourceBranchId pulumi.StringInput
+	// Restore the source as of this RFC 3339 point in time. Only one of lsn and timestamp may be set.
+	Timestamp pulumi.StringPtrInput
+}
+
+func (BranchRestoreArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*branchRestoreArgs)(nil)).Elem()
+}
+
+// The branch after a method has changed it.
+type BranchRestoreResult struct {
+	// The ID of the branch.
+	BranchId string `pulumi:"branchId"`
+	// The name of the branch.
+	Name string `pulumi:"name"`
+	// The ID of the branch's parent.
+	ParentId *string `pulumi:"parentId"`
+	// The Log Sequence Number of the parent the branch now starts from.
+	ParentLsn *string `pulumi:"parentLsn"`
+	// The point in time of the parent the branch now starts from.
+	ParentTimestamp *string `pulumi:"parentTimestamp"`
+}
+
+type BranchRestoreResultOutput struct{ *pulumi.OutputState }
+
+func (BranchRestoreResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BranchRestoreResult)(nil)).Elem()
+}
+
+// The ID of the branch.
+func (o BranchRestoreResultOutput) BranchId() pulumi.StringOutput {
+	return o.ApplyT(func(v BranchRestoreResult) string { return v.BranchId }).(pulumi.StringOutput)
+}
+
+// The name of the branch.
+func (o BranchRestoreResultOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v BranchRestoreResult) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The ID of the branch's parent.
+func (o BranchRestoreResultOutput) ParentId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BranchRestoreResult) *string { return v.ParentId }).(pulumi.StringPtrOutput)
+}
+
+// The Log Sequence Number of the parent the branch now starts from.
+func (o BranchRestoreResultOutput) ParentLsn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BranchRestoreResult) *string { return v.ParentLsn }).(pulumi.StringPtrOutput)
+}
+
+// The point in time of the parent the branch now starts from.
+func (o BranchRestoreResultOutput) ParentTimestamp() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BranchRestoreResult) *string { return v.ParentTimestamp }).(pulumi.StringPtrOutput)
+}
+
+type BranchInput interface {
+	pulumi.Input
+
+	ToBranchOutput() BranchOutput
+	ToBranchOutputWithContext(ctx context.Context) BranchOutput
+}
+
+func (*Branch) ElementType() reflect.Type {
+	return reflect.TypeOf((**Branch)(nil)).Elem()
+}
+
+func (i *Branch) ToBranchOutput() BranchOutput {
+	return i.ToBranchOutputWithContext(context.Background())
+}
+
+func (i *Branch) ToBranchOutputWithContext(ctx context.Context) BranchOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BranchOutput)
+}
+
+type BranchOutput struct{ *pulumi.OutputState }
+
+func (BranchOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Branch)(nil)).Elem()
+}
+
+func (o BranchOutput) ToBranchOutput() BranchOutput {
+	return o
+}
+
+func (o BranchOutput) ToBranchOutputWithContext(ctx context.Context) BranchOutput {
+	return o
+}
+
+// The ID Neon assigned to the branch.
+func (o BranchOutput) BranchId() pulumi.StringOutput {
+	return o.ApplyT(func(v *Branch) pulumi.StringOutput { return v.BranchId }).(pulumi.StringOutput)
+}
+
+// When the branch was created, in RFC 3339 format.
+func (o BranchOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *Branch) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+// The endpoints created from endpoints, in the same order. They are deleted along with the branch.
+func (o BranchOutput) CreatedEndpoints() CreatedEndpointArrayOutput {
+	return o.ApplyT(func(v *Branch) CreatedEndpointArrayOutput { return v.CreatedEndpoints }).(CreatedEndpointArrayOutput)
+}
+
+// Compute endpoints to create together with the branch. Changing them replaces the branch.
+func (o BranchOutput) Endpoints() BranchEndpointArrayOutput {
+	return o.ApplyT(func(v *Branch) BranchEndpointArrayOutput { return v.Endpoints }).(BranchEndpointArrayOutput)
+}
+
+// The RFC 3339 time at which Neon deletes the branch. Only one of expiresAt and ttl may be set.
+func (o BranchOutput) ExpiresAt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Branch) pulumi.StringPtrOutput { return v.ExpiresAt }).(pulumi.StringPtrOutput)
+}
+
+// The name of the branch.
+func (o BranchOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *Branch) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// The ID of the branch to branch from. Defaults to the project's default branch. Changing it replaces the branch.
+func (o BranchOutput) ParentId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Branch) pulumi.StringPtrOutput { return v.ParentId }).(pulumi.StringPtrOutput)
+}
+
+// Branch from the parent as of this Log Sequence Number. Changing it replaces the branch.
+func (o BranchOutput) ParentLsn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Branch) pulumi.StringPtrOutput { return v.ParentLsn }).(pulumi.StringPtrOutput)
+}
+
+// Branch from the parent as of this RFC 3339 point in time. Changing it replaces the branch.
+func (o BranchOutput) ParentTimestamp() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Branch) pulumi.StringPtrOutput { return v.ParentTimestamp }).(pulumi.StringPtrOutput)
+}
+
+// The ID of the project the branch belongs to. Changing it replaces the branch.
+func (o BranchOutput) ProjectId() pulumi.StringOutput {
+	return o.ApplyT(func(v *Branch) pulumi.StringOutput { return v.ProjectId }).(pulumi.StringOutput)
+}
+
+// How long after its creation Neon deletes the branch, as a duration such as 72h. Only one of expiresAt and ttl may be set.
+func (o BranchOutput) Ttl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Branch) pulumi.StringPtrOutput { return v.Ttl }).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*BranchInput)(nil)).Elem(), &Branch{})
+	pulumi.RegisterOutputType(BranchOutput{})
+	pulumi.RegisterOutputType(BranchResetToParentResultOutput{})
+	pulumi.RegisterOutputType(BranchRestoreResultOutput{})
+}
@@ -0,0 +1,47 @@
+// Code generated by pulumi-gen-neon DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package config
+
+import (
+	"github.com/DonsWayo/pulumi-neon/sdk/go/neon/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi/config"
+)
+
+var _ = internal.GetEnvOrDefault
+
+// The Neon API key. Defaults to the NEON_API_KEY environment variable.
+func GetApiKey(ctx *pulumi.Context) string {
+	return config.Get(ctx, "neon:apiKey")
+}
+
+// The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+func GetApiUrl(ctx *pulumi.Context) string {
+	return config.Get(ctx, "neon:apiUrl")
+}
+
+// How many times a failed request to the Neon API is retried. Zero disables retries.
+func GetMaxRetries(ctx *pulumi.Context) int {
+	return config.GetInt(ctx, "neon:maxRetries")
+}
+
+// The organization that projects are created in when they do not set their own orgId.
+func GetOrgId(ctx *pulumi.Context) string {
+	return config.Get(ctx, "neon:orgId")
+}
+
+// How long a single request to the Neon API may take.
+func GetRequestTimeoutSeconds(ctx *pulumi.Context) int {
+	return config.GetInt(ctx, "neon:requestTimeoutSeconds")
+}
+
+// How long a request keeps retrying while Neon reports it as locked, rate limited or unavailable.
+func GetRetryTimeoutSeconds(ctx *pulumi.Context) int {
+	return config.GetInt(ctx, "neon:retryTimeoutSeconds")
+}
+
+// Text appended to the User-Agent header of every request.
+func GetUserAgentSuffix(ctx *pulumi.Context) string {
+	return config.Get(ctx, "neon:userAgentSuffix")
+}
@@ -0,0 +1,177 @@
+// Code generated by pulumi-gen-neon DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package neon
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/DonsWayo/pulumi-neon/sdk/go/neon/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// A Postgres database on a Neon branch. Import it with an ID of the form projectId/branchId/databaseId, where the database's name may be given in place of its ID.
+type Database struct {
+	pulumi.CustomResourceState
+
+	// The ID of the branch the database is on. Changing it replaces the database.
+	BranchId pulumi.StringOutput `pulumi:"branchId"`
+	// When the database was created, in RFC 3339 format.
+	CreatedAt pulumi.StringOutput `pulumi:"createdAt"`
+	// The ID Neon assigned to the database.
+	DatabaseId pulumi.StringOutput `pulumi:"databaseId"`
+	// The name of the database.
+	Name pulumi.StringOutput `pulumi:"name"`
+	// The role that owns the database, typically the name of a Role resource.
+	OwnerName pulumi.StringOutput `pulumi:"ownerName"`
+	// The ID of the project the database belongs to. Changing it replaces the database.
+	ProjectId pulumi.StringOutput `pulumi:"projectId"`
+}
+
+// NewDatabase registers a new resource with the given unique name, arguments, and options.
+func NewDatabase(ctx *pulumi.Context,
+	name string, args *DatabaseArgs, opts ...pulumi.ResourceOption) (*Database, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.BranchId == nil {
+		return nil, errors.New("invalid value for required argument 'BranchId'")
+	}
+	if args.Name == nil {
+		return nil, errors.New("invalid value for required argument 'Name'")
+	}
+	if args.OwnerName == nil {
+		return nil, errors.New("invalid value for required argument 'OwnerName'")
+	}
+	if args.ProjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ProjectId'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource Database
+	err := ctx.RegisterResource("neon:index:Database", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetDatabase gets an existing Database resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetDatabase(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *DatabaseState, opts ...pulumi.ResourceOption) (*Database, error) {
+	var resource Database
+	err := ctx.ReadResource("neon:index:Database", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Database resources.
+type databaseState struct {
+}
+
+type DatabaseState struct {
+}
+
+func (DatabaseState) ElementType() reflect.Type {
+	return reflect.TypeOf((*databaseState)(nil)).Elem()
+}
+
+type databaseArgs struct {
+	// The ID of the branch the database is on. Changing it replaces the database.
+	BranchId string `pulumi:"branchId"`
+	// The name of the database.
+	Name string `pulumi:"name"`
+	// The role that owns the database, typically the name of a Role resource.
+	OwnerName string `pulumi:"ownerName"`
+	// The ID of the project the database belongs to. Changing it replaces the database.
+	ProjectId string `pulumi:"projectId"`
+}
+
+// The set of arguments for constructing a Database resource.
+type DatabaseArgs struct {
+	// The ID of the branch the database is on. Changing it replaces the database.
+	BranchId pulumi.StringInput
+	// The name of the database.
+	Name pulumi.StringInput
+	// The role that owns the database, typically the name of a Role resource.
+	OwnerName pulumi.StringInput
+	// The ID of the project the database belongs to. Changing it replaces the database.
+	ProjectId pulumi.StringInput
+}
+
+func (DatabaseArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*databaseArgs)(nil)).Elem()
+}
+
+type DatabaseInput interface {
+	pulumi.Input
+
+	ToDatabaseOutput() DatabaseOutput
+	ToDatabaseOutputWithContext(ctx context.Context) DatabaseOutput
+}
+
+func (*Database) ElementType() reflect.Type {
+	return reflect.TypeOf((**Database)(nil)).Elem()
+}
+
+func (i *Database) ToDatabaseOutput() DatabaseOutput {
+	return i.ToDatabaseOutputWithContext(context.Background())
+}
+
+func (i *Database) ToDatabaseOutputWithContext(ctx context.Context) DatabaseOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DatabaseOutput)
+}
+
+type DatabaseOutput struct{ *pulumi.OutputState }
+
+func (DatabaseOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Database)(nil)).Elem()
+}
+
+func (o DatabaseOutput) ToDatabaseOutput() DatabaseOutput {
+	return o
+}
+
+func (o DatabaseOutput) ToDatabaseOutputWithContext(ctx context.Context) DatabaseOutput {
+	return o
+}
+
+// The ID of the branch the database is on. Changing it replaces the database.
+func (o DatabaseOutput) BranchId() pulumi.StringOutput {
+	return o.ApplyT(func(v *Database) pulumi.StringOutput { return v.BranchId }).(pulumi.StringOutput)
+}
+
+// When the database was created, in RFC 3339 format.
+func (o DatabaseOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *Database) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+// The ID Neon assigned to the database.
+func (o DatabaseOutput) DatabaseId() pulumi.StringOutput {
+	return o.ApplyT(func(v *Database) pulumi.StringOutput { return v.DatabaseId }).(pulumi.StringOutput)
+}
+
+// The name of the database.
+func (o DatabaseOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *Database) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// The role that owns the database, typically the name of a Role resource.
+func (o DatabaseOutput) OwnerName() pulumi.StringOutput {
+	return o.ApplyT(func(v *Database) pulumi.StringOutput { return v.OwnerName }).(pulumi.StringOutput)
+}
+
+// The ID of the project the database belongs to. Changing it replaces the database.
+func (o DatabaseOutput) ProjectId() pulumi.StringOutput {
+	return o.ApplyT(func(v *Database) pulumi.StringOutput { return v.ProjectId }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*DatabaseInput)(nil)).Elem(), &Database{})
+	pulumi.RegisterOutputType(DatabaseOutput{})
+}
@@ -0,0 +1,2 @@
+// A Pulumi provider for managing Neon serverless Postgres projects, branches, endpoints, databases and roles.
+package neon
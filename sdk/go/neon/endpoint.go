@@ -0,0 +1,334 @@
+// Code generated by pulumi-gen-neon DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package neon
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/DonsWayo/pulumi-neon/sdk/go/neon/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// A compute endpoint on a Neon branch. Import it with an ID of the form projectId/endpointId.
+type Endpoint struct {
+	pulumi.CustomResourceState
+
+	// The maximum compute size, in compute units.
+	AutoscalingLimitMaxCu pulumi.Float64PtrOutput `pulumi:"autoscalingLimitMaxCu"`
+	// The minimum compute size, in compute units.
+	AutoscalingLimitMinCu pulumi.Float64PtrOutput `pulumi:"autoscalingLimitMinCu"`
+	// The ID of the branch the endpoint serves. Changing it moves the endpoint to that branch.
+	BranchId pulumi.StringOutput `pulumi:"branchId"`
+	// A connection URI for databaseName as roleName. It embeds the role's password.
+	ConnectionUri pulumi.StringPtrOutput `pulumi:"connectionUri"`
+	// When the endpoint was created, in RFC 3339 format.
+	CreatedAt pulumi.StringOutput `pulumi:"createdAt"`
+	// The state of the endpoint's compute, such as active or idle.
+	CurrentState pulumi.StringPtrOutput `pulumi:"currentState"`
+	// The database the connection URIs connect to. The URIs are only filled in when roleName is set too.
+	DatabaseName pulumi.StringPtrOutput `pulumi:"databaseName"`
+	// The ID Neon assigned to the endpoint.
+	EndpointId pulumi.StringOutput `pulumi:"endpointId"`
+	// The hostname of the endpoint.
+	Host pulumi.StringOutput `pulumi:"host"`
+	// When the endpoint was last active, in RFC 3339 format.
+	LastActive pulumi.StringPtrOutput `pulumi:"lastActive"`
+	// A connection URI for databaseName as roleName that goes through the connection pooler. It embeds the role's password.
+	PooledConnectionUri pulumi.StringPtrOutput `pulumi:"pooledConnectionUri"`
+	// Whether connections can go through the PgBouncer connection pooler.
+	PoolerEnabled pulumi.BoolPtrOutput `pulumi:"poolerEnabled"`
+	// The hostname that routes connections through PgBouncer.
+	PoolerHost pulumi.StringPtrOutput `pulumi:"poolerHost"`
+	// The connection pooler mode, such as transaction.
+	PoolerMode pulumi.StringPtrOutput `pulumi:"poolerMode"`
+	// The port the endpoint accepts connections on.
+	Port pulumi.IntPtrOutput `pulumi:"port"`
+	// The ID of the project the endpoint belongs to. Changing it replaces the endpoint.
+	ProjectId pulumi.StringOutput `pulumi:"projectId"`
+	// The compute provisioner, k8s-pod or k8s-neonvm.
+	Provisioner pulumi.StringPtrOutput `pulumi:"provisioner"`
+	// The hostname of the proxy in front of the endpoint.
+	ProxyHost pulumi.StringPtrOutput `pulumi:"proxyHost"`
+	// The region of the endpoint, which must match the project's region. Changing it replaces the endpoint.
+	RegionId pulumi.StringPtrOutput `pulumi:"regionId"`
+	// The role whose credentials the connection URIs use. The URIs are only filled in when databaseName is set too.
+	RoleName pulumi.StringPtrOutput `pulumi:"roleName"`
+	// Postgres settings applied to the endpoint's compute.
+	Settings pulumi.StringMapOutput `pulumi:"settings"`
+	// How long, in seconds, an idle endpoint keeps running before it is suspended.
+	SuspendTimeoutSeconds pulumi.IntPtrOutput `pulumi:"suspendTimeoutSeconds"`
+	// The endpoint type, read_write or read_only. Changing it replaces the endpoint.
+	Type pulumi.StringOutput `pulumi:"type"`
+}
+
+// NewEndpoint registers a new resource with the given unique name, arguments, and options.
+func NewEndpoint(ctx *pulumi.Context,
+	name string, args *EndpointArgs, opts ...pulumi.ResourceOption) (*Endpoint, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.BranchId == nil {
+		return nil, errors.New("invalid value for required argument 'BranchId'")
+	}
+	if args.ProjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ProjectId'")
+	}
+	if args.Type == nil {
+		return nil, errors.New("invalid value for required argument 'Type'")
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"connectionUri",
+		"pooledConnectionUri",
+	})
+	opts = append(opts, secrets)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource Endpoint
+	err := ctx.RegisterResource("neon:index:Endpoint", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetEndpoint gets an existing Endpoint resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetEndpoint(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *EndpointState, opts ...pulumi.ResourceOption) (*Endpoint, error) {
+	var resource Endpoint
+	err := ctx.ReadResource("neon:index:Endpoint", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Endpoint resources.
+type endpointState struct {
+}
+
+type EndpointState struct {
+}
+
+func (EndpointState) ElementType() reflect.Type {
+	return reflect.TypeOf((*endpointState)(nil)).Elem()
+}
+
+type endpointArgs struct {
+	// The maximum compute size, in compute units.
+	AutoscalingLimitMaxCu *float64 `pulumi:"autoscalingLimitMaxCu"`
+	// The minimum compute size, in compute units.
+	AutoscalingLimitMinCu *float64 `pulumi:"autoscalingLimitMinCu"`
+	// The ID of the branch the endpoint serves. Changing it moves the endpoint to that branch.
+	BranchId string `pulumi:"branchId"`
+	// The database the connection URIs connect to. The URIs are only filled in when roleName is set too.
+	DatabaseName *string `pulumi:"databaseName"`
+	// Whether connections can go through the PgBouncer connection pooler.
+	PoolerEnabled *bool `pulumi:"poolerEnabled"`
+	// The connection pooler mode, such as transaction.
+	PoolerMode *string `pulumi:"poolerMode"`
+	// The ID of the project the endpoint belongs to. Changing it replaces the endpoint.
+	ProjectId string `pulumi:"projectId"`
+	// The compute provisioner, k8s-pod or k8s-neonvm.
+	Provisioner *string `pulumi:"provisioner"`
+	// The region of the endpoint, which must match the project's region. Changing it replaces the endpoint.
+	RegionId *string `pulumi:"regionId"`
+	// The role whose credentials the connection URIs use. The URIs are only filled in when databaseName is set too.
+	RoleName *string `pulumi:"roleName"`
+	// Postgres settings applied to the endpoint's compute.
+	Settings map[string]string `pulumi:"settings"`
+	// How long, in seconds, an idle endpoint keeps running before it is suspended.
+	SuspendTimeoutSeconds *int `pulumi:"suspendTimeoutSeconds"`
+	// The endpoint type, read_write or read_only. Changing it replaces the endpoint.
+	Type string `pulumi:"type"`
+}
+
+// The set of arguments for constructing a Endpoint resource.
+type EndpointArgs struct {
+	// The maximum compute size, in compute units.
+	AutoscalingLimitMaxCu pulumi.Float64PtrInput
+	// The minimum compute size, in compute units.
+	AutoscalingLimitMinCu pulumi.Float64PtrInput
+	// The ID of the branch the endpoint serves. Changing it moves the endpoint to that branch.
+	BranchId pulumi.StringInput
+	// The database the connection URIs connect to. The URIs are only filled in when roleName is set too.
+	DatabaseName pulumi.StringPtrInput
+	// Whether connections can go through the PgBouncer connection pooler.
+	PoolerEnabled pulumi.BoolPtrInput
+	// The connection pooler mode, such as transaction.
+	PoolerMode pulumi.StringPtrInput
+	// The ID of the project the endpoint belongs to. Changing it replaces the endpoint.
+	ProjectId pulumi.StringInput
+	// The compute provisioner, k8s-pod or k8s-neonvm.
+	Provisioner pulumi.StringPtrInput
+	// The region of the endpoint, which must match the project's region. Changing it replaces the endpoint.
+	RegionId pulumi.StringPtrInput
+	// The role whose credentials the connection URIs use. The URIs are only filled in when databaseName is set too.
+	RoleName pulumi.StringPtrInput
+	// Postgres settings applied to the endpoint's compute.
+	Settings pulumi.StringMapInput
+	// How long, in seconds, an idle endpoint keeps running before it is suspended.
+	SuspendTimeoutSeconds pulumi.IntPtrInput
+	// The endpoint type, read_write or read_only. Changing it replaces the endpoint.
+	Type pulumi.StringInput
+}
+
+func (EndpointArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*endpointArgs)(nil)).Elem()
+}
+
+type EndpointInput interface {
+	pulumi.Input
+
+	ToEndpointOutput() EndpointOutput
+	ToEndpointOutputWithContext(ctx context.Context) EndpointOutput
+}
+
+func (*Endpoint) ElementType() reflect.Type {
+	return reflect.TypeOf((**Endpoint)(nil)).Elem()
+}
+
+func (i *Endpoint) ToEndpointOutput() EndpointOutput {
+	return i.ToEndpointOutputWithContext(context.Background())
+}
+
+func (i *Endpoint) ToEndpointOutputWithContext(ctx context.Context) EndpointOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EndpointOutput)
+}
+
+type EndpointOutput struct{ *pulumi.OutputState }
+
+func (EndpointOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Endpoint)(nil)).Elem()
+}
+
+func (o EndpointOutput) ToEndpointOutput() EndpointOutput {
+	return o
+}
+
+func (o EndpointOutput) ToEndpointOutputWithContext(ctx context.Context) EndpointOutput {
+	return o
+}
+
+// The maximum compute size, in compute units.
+func (o EndpointOutput) AutoscalingLimitMaxCu() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.Float64PtrOutput { return v.AutoscalingLimitMaxCu }).(pulumi.Float64PtrOutput)
+}
+
+// The minimum compute size, in compute units.
+func (o EndpointOutput) AutoscalingLimitMinCu() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.Float64PtrOutput { return v.AutoscalingLimitMinCu }).(pulumi.Float64PtrOutput)
+}
+
+// The ID of the branch the endpoint serves. Changing it moves the endpoint to that branch.
+func (o EndpointOutput) BranchId() pulumi.StringOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringOutput { return v.BranchId }).(pulumi.StringOutput)
+}
+
+// A connection URI for databaseName as roleName. It embeds the role's password.
+func (o EndpointOutput) ConnectionUri() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringPtrOutput { return v.ConnectionUri }).(pulumi.StringPtrOutput)
+}
+
+// When the endpoint was created, in RFC 3339 format.
+func (o EndpointOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+// The state of the endpoint's compute, such as active or idle.
+func (o EndpointOutput) CurrentState() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringPtrOutput { return v.CurrentState }).(pulumi.StringPtrOutput)
+}
+
+// The database the connection URIs connect to. The URIs are only filled in when roleName is set too.
+func (o EndpointOutput) DatabaseName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringPtrOutput { return v.DatabaseName }).(pulumi.StringPtrOutput)
+}
+
+// The ID Neon assigned to the endpoint.
+func (o EndpointOutput) EndpointId() pulumi.StringOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringOutput { return v.EndpointId }).(pulumi.StringOutput)
+}
+
+// The hostname of the endpoint.
+func (o EndpointOutput) Host() pulumi.StringOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringOutput { return v.Host }).(pulumi.StringOutput)
+}
+
+// When the endpoint was last active, in RFC 3339 format.
+func (o EndpointOutput) LastActive() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringPtrOutput { return v.LastActive }).(pulumi.StringPtrOutput)
+}
+
+// A connection URI for databaseName as roleName that goes through the connection pooler. It embeds the role's password.
+func (o EndpointOutput) PooledConnectionUri() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringPtrOutput { return v.PooledConnectionUri }).(pulumi.StringPtrOutput)
+}
+
+// Whether connections can go through the PgBouncer connection pooler.
+func (o EndpointOutput) PoolerEnabled() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.BoolPtrOutput { return v.PoolerEnabled }).(pulumi.BoolPtrOutput)
+}
+
+// The hostname that routes connections through PgBouncer.
+func (o EndpointOutput) PoolerHost() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringPtrOutput { return v.PoolerHost }).(pulumi.StringPtrOutput)
+}
+
+// The connection pooler mode, such as transaction.
+func (o EndpointOutput) PoolerMode() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringPtrOutput { return v.PoolerMode }).(pulumi.StringPtrOutput)
+}
+
+// The port the endpoint accepts connections on.
+func (o EndpointOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.IntPtrOutput { return v.Port }).(pulumi.IntPtrOutput)
+}
+
+// The ID of the project the endpoint belongs to. Changing it replaces the endpoint.
+func (o EndpointOutput) ProjectId() pulumi.StringOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringOutput { return v.ProjectId }).(pulumi.StringOutput)
+}
+
+// The compute provisioner, k8s-pod or k8s-neonvm.
+func (o EndpointOutput) Provisioner() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringPtrOutput { return v.Provisioner }).(pulumi.StringPtrOutput)
+}
+
+// The hostname of the proxy in front of the endpoint.
+func (o EndpointOutput) ProxyHost() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringPtrOutput { return v.ProxyHost }).(pulumi.StringPtrOutput)
+}
+
+// The region of the endpoint, which must match the project's region. Changing it replaces the endpoint.
+func (o EndpointOutput) RegionId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringPtrOutput { return v.RegionId }).(pulumi.StringPtrOutput)
+}
+
+// The role whose credentials the connection URIs use. The URIs are only filled in when databaseName is set too.
+func (o EndpointOutput) RoleName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringPtrOutput { return v.RoleName }).(pulumi.StringPtrOutput)
+}
+
+// Postgres settings applied to the endpoint's compute.
+func (o EndpointOutput) Settings() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringMapOutput { return v.Settings }).(pulumi.StringMapOutput)
+}
+
+// How long, in seconds, an idle endpoint keeps running before it is suspended.
+func (o EndpointOutput) SuspendTimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.IntPtrOutput { return v.SuspendTimeoutSeconds }).(pulumi.IntPtrOutput)
+}
+
+// The endpoint type, read_write or read_only. Changing it replaces the endpoint.
+func (o EndpointOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v *Endpoint) pulumi.StringOutput { return v.Type }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*EndpointInput)(nil)).Elem(), &Endpoint{})
+	pulumi.RegisterOutputType(EndpointOutput{})
+}
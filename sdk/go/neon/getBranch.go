@@ -0,0 +1,124 @@
+// Code generated by pulumi-gen-neon DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package neon
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/DonsWayo/pulumi-neon/sdk/go/neon/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Look up a branch of a Neon project by its name.
+func LookupBranch(ctx *pulumi.Context, args *LookupBranchArgs, opts ...pulumi.InvokeOption) (*LookupBranchResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv LookupBranchResult
+	err := ctx.Invoke("neon:index:getBranch", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type LookupBranchArgs struct {
+	// The name of the branch to look up.
+	Name string `pulumi:"name"`
+	// The ID of the project the branch belongs to.
+	ProjectId string `pulumi:"projectId"`
+}
+
+type LookupBranchResult struct {
+	// The ID of the branch.
+	BranchId string `pulumi:"branchId"`
+	// When the branch was created, in RFC 3339 format.
+	CreatedAt string `pulumi:"createdAt"`
+	// The name of the branch.
+	Name string `pulumi:"name"`
+	// The ID of the branch it was branched from.
+	ParentId *string `pulumi:"parentId"`
+	// The Log Sequence Number of the parent it was branched from.
+	ParentLsn *string `pulumi:"parentLsn"`
+	// The point in time of the parent it was branched from.
+	ParentTimestamp *string `pulumi:"parentTimestamp"`
+	// The ID of the project the branch belongs to.
+	ProjectId string `pulumi:"projectId"`
+}
+
+func LookupBranchOutput(ctx *pulumi.Context, args LookupBranchOutputArgs, opts ...pulumi.InvokeOption) LookupBranchResultOutput {
+	return pulumi.ToOutputWithContext(context.Background(), args).
+		ApplyT(func(v interface{}) (LookupBranchResult, error) {
+			args := v.(LookupBranchArgs)
+			r, err := LookupBranch(ctx, &args, opts...)
+			var s LookupBranchResult
+			if r != nil {
+				s = *r
+			}
+			return s, err
+		}).(LookupBranchResultOutput)
+}
+
+type LookupBranchOutputArgs struct {
+	// The name of the branch to look up.
+	Name pulumi.StringInput `pulumi:"name"`
+	// The ID of the project the branch belongs to.
+	ProjectId pulumi.StringInput `pulumi:"projectId"`
+}
+
+func (LookupBranchOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupBranchArgs)(nil)).Elem()
+}
+
+type LookupBranchResultOutput struct{ *pulumi.OutputState }
+
+func (LookupBranchResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupBranchResult)(nil)).Elem()
+}
+
+func (o LookupBranchResultOutput) ToLookupBranchResultOutput() LookupBranchResultOutput {
+	return o
+}
+
+func (o LookupBranchResultOutput) ToLookupBranchResultOutputWithContext(ctx context.Context) LookupBranchResultOutput {
+	return o
+}
+
+// The ID of the branch.
+func (o LookupBranchResultOutput) BranchId() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupBranchResult) string { return v.BranchId }).(pulumi.StringOutput)
+}
+
+// When the branch was created, in RFC 3339 format.
+func (o LookupBranchResultOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupBranchResult) string { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+// The name of the branch.
+func (o LookupBranchResultOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupBranchResult) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The ID of the branch it was branched from.
+func (o LookupBranchResultOutput) ParentId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LookupBranchResult) *string { return v.ParentId }).(pulumi.StringPtrOutput)
+}
+
+// The Log Sequence Number of the parent it was branched from.
+func (o LookupBranchResultOutput) ParentLsn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LookupBranchResult) *string { return v.ParentLsn }).(pulumi.StringPtrOutput)
+}
+
+// The point in time of the parent it was branched from.
+func (o LookupBranchResultOutput) ParentTimestamp() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LookupBranchResult) *string { return v.ParentTimestamp }).(pulumi.StringPtrOutput)
+}
+
+// The ID of the project the branch belongs to.
+func (o LookupBranchResultOutput) ProjectId() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupBranchResult) string { return v.ProjectId }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(LookupBranchResultOutput{})
+}
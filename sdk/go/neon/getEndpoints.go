@@ -0,0 +1,82 @@
+// Code generated by pulumi-gen-neon DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package neon
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/DonsWayo/pulumi-neon/sdk/go/neon/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// List the compute endpoints on a branch of a Neon project.
+func GetEndpoints(ctx *pulumi.Context, args *GetEndpointsArgs, opts ...pulumi.InvokeOption) (*GetEndpointsResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetEndpointsResult
+	err := ctx.Invoke("neon:index:getEndpoints", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetEndpointsArgs struct {
+	// The ID of the branch whose endpoints are listed.
+	BranchId string `pulumi:"branchId"`
+	// The ID of the project the branch belongs to.
+	ProjectId string `pulumi:"projectId"`
+}
+
+type GetEndpointsResult struct {
+	// The endpoints on the branch.
+	Endpoints []EndpointSummary `pulumi:"endpoints"`
+}
+
+func GetEndpointsOutput(ctx *pulumi.Context, args GetEndpointsOutputArgs, opts ...pulumi.InvokeOption) GetEndpointsResultOutput {
+	return pulumi.ToOutputWithContext(context.Background(), args).
+		ApplyT(func(v interface{}) (GetEndpointsResult, error) {
+			args := v.(GetEndpointsArgs)
+			r, err := GetEndpoints(ctx, &args, opts...)
+			var s GetEndpointsResult
+			if r != nil {
+				s = *r
+			}
+			return s, err
+		}).(GetEndpointsResultOutput)
+}
+
+type GetEndpointsOutputArgs struct {
+	// The ID of the branch whose endpoints are listed.
+	BranchId pulumi.StringInput `pulumi:"branchId"`
+	// The ID of the project the branch belongs to.
+	ProjectId pulumi.StringInput `pulumi:"projectId"`
+}
+
+func (GetEndpointsOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetEndpointsArgs)(nil)).Elem()
+}
+
+type GetEndpointsResultOutput struct{ *pulumi.OutputState }
+
+func (GetEndpointsResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetEndpointsResult)(nil)).Elem()
+}
+
+func (o GetEndpointsResultOutput) ToGetEndpointsResultOutput() GetEndpointsResultOutput {
+	return o
+}
+
+func (o GetEndpointsResultOutput) ToGetEndpointsResultOutputWithContext(ctx context.Context) GetEndpointsResultOutput {
+	return o
+}
+
+// The endpoints on the branch.
+func (o GetEndpointsResultOutput) Endpoints() EndpointSummaryArrayOutput {
+	return o.ApplyT(func(v GetEndpointsResult) []EndpointSummary { return v.Endpoints }).(EndpointSummaryArrayOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetEndpointsResultOutput{})
+}
@@ -0,0 +1,149 @@
+// Code generated by pulumi-gen-neon DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package neon
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/DonsWayo/pulumi-neon/sdk/go/neon/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Look up a Neon project by its ID or, within an organization, by its name.
+func LookupProject(ctx *pulumi.Context, args *LookupProjectArgs, opts ...pulumi.InvokeOption) (*LookupProjectResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv LookupProjectResult
+	err := ctx.Invoke("neon:index:getProject", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type LookupProjectArgs struct {
+	// The name of the project to look up. Exactly one of projectId and name must be set.
+	Name *string `pulumi:"name"`
+	// The organization searched for a project by name. Defaults to the provider's orgId.
+	OrgId *string `pulumi:"orgId"`
+	// The ID of the project to look up. Exactly one of projectId and name must be set.
+	ProjectId *string `pulumi:"projectId"`
+}
+
+type LookupProjectResult struct {
+	// When the project was created, in RFC 3339 format.
+	CreatedAt string `pulumi:"createdAt"`
+	// The compute settings applied to endpoints created in the project.
+	DefaultEndpointSettings *DefaultEndpointSettings `pulumi:"defaultEndpointSettings"`
+	// How long, in seconds, Neon keeps the history that branches can be created from.
+	HistoryRetentionSeconds *int `pulumi:"historyRetentionSeconds"`
+	// The name of the project.
+	Name string `pulumi:"name"`
+	// The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+	OrgId *string `pulumi:"orgId"`
+	// The major Postgres version. Changing it replaces the project.
+	PgVersion *int `pulumi:"pgVersion"`
+	// The ID of the project.
+	ProjectId string `pulumi:"projectId"`
+	// The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+	Provisioner *string `pulumi:"provisioner"`
+	// The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+	RegionId string `pulumi:"regionId"`
+	// Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+	StorePasswords *bool `pulumi:"storePasswords"`
+}
+
+func LookupProjectOutput(ctx *pulumi.Context, args LookupProjectOutputArgs, opts ...pulumi.InvokeOption) LookupProjectResultOutput {
+	return pulumi.ToOutputWithContext(context.Background(), args).
+		ApplyT(func(v interface{}) (LookupProjectResult, error) {
+			args := v.(LookupProjectArgs)
+			r, err := LookupProject(ctx, &args, opts...)
+			var s LookupProjectResult
+			if r != nil {
+				s = *r
+			}
+			return s, err
+		}).(LookupProjectResultOutput)
+}
+
+type LookupProjectOutputArgs struct {
+	// The name of the project to look up. Exactly one of projectId and name must be set.
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// The organization searched for a project by name. Defaults to the provider's orgId.
+	OrgId pulumi.StringPtrInput `pulumi:"orgId"`
+	// The ID of the project to look up. Exactly one of projectId and name must be set.
+	ProjectId pulumi.StringPtrInput `pulumi:"projectId"`
+}
+
+func (LookupProjectOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupProjectArgs)(nil)).Elem()
+}
+
+type LookupProjectResultOutput struct{ *pulumi.OutputState }
+
+func (LookupProjectResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupProjectResult)(nil)).Elem()
+}
+
+func (o LookupProjectResultOutput) ToLookupProjectResultOutput() LookupProjectResultOutput {
+	return o
+}
+
+func (o LookupProjectResultOutput) ToLookupProjectResultOutputWithContext(ctx context.Context) LookupProjectResultOutput {
+	return o
+}
+
+// When the project was created, in RFC 3339 format.
+func (o LookupProjectResultOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupProjectResult) string { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+// The compute settings applied to endpoints created in the project.
+func (o LookupProjectResultOutput) DefaultEndpointSettings() DefaultEndpointSettingsPtrOutput {
+	return o.ApplyT(func(v LookupProjectResult) *DefaultEndpointSettings { return v.DefaultEndpointSettings }).(DefaultEndpointSettingsPtrOutput)
+}
+
+// How long, in seconds, Neon keeps the history that branches can be created from.
+func (o LookupProjectResultOutput) HistoryRetentionSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v LookupProjectResult) *int { return v.HistoryRetentionSeconds }).(pulumi.IntPtrOutput)
+}
+
+// The name of the project.
+func (o LookupProjectResultOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupProjectResult) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+func (o LookupProjectResultOutput) OrgId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LookupProjectResult) *string { return v.OrgId }).(pulumi.StringPtrOutput)
+}
+
+// The major Postgres version. Changing it replaces the project.
+func (o LookupProjectResultOutput) PgVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v LookupProjectResult) *int { return v.PgVersion }).(pulumi.IntPtrOutput)
+}
+
+// The ID of the project.
+func (o LookupProjectResultOutput) ProjectId() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupProjectResult) string { return v.ProjectId }).(pulumi.StringOutput)
+}
+
+// The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+func (o LookupProjectResultOutput) Provisioner() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v LookupProjectResult) *string { return v.Provisioner }).(pulumi.StringPtrOutput)
+}
+
+// The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+func (o LookupProjectResultOutput) RegionId() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupProjectResult) string { return v.RegionId }).(pulumi.StringOutput)
+}
+
+// Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+func (o LookupProjectResultOutput) StorePasswords() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v LookupProjectResult) *bool { return v.StorePasswords }).(pulumi.BoolPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(LookupProjectResultOutput{})
+}
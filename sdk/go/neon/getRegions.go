@@ -0,0 +1,74 @@
+// Code generated by pulumi-gen-neon DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package neon
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/DonsWayo/pulumi-neon/sdk/go/neon/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// List the regions Neon can create projects in.
+func GetRegions(ctx *pulumi.Context, args *GetRegionsArgs, opts ...pulumi.InvokeOption) (*GetRegionsResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetRegionsResult
+	err := ctx.Invoke("neon:index:getRegions", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetRegionsArgs struct {
+}
+
+type GetRegionsResult struct {
+	// The regions Neon can create projects in.
+	Regions []Region `pulumi:"regions"`
+}
+
+func GetRegionsOutput(ctx *pulumi.Context, args GetRegionsOutputArgs, opts ...pulumi.InvokeOption) GetRegionsResultOutput {
+	return pulumi.ToOutputWithContext(context.Background(), args).
+		ApplyT(func(v interface{}) (GetRegionsResult, error) {
+			args := v.(GetRegionsArgs)
+			r, err := GetRegions(ctx, &args, opts...)
+			var s GetRegionsResult
+			if r != nil {
+				s = *r
+			}
+			return s, err
+		}).(GetRegionsResultOutput)
+}
+
+type GetRegionsOutputArgs struct {
+}
+
+func (GetRegionsOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetRegionsArgs)(nil)).Elem()
+}
+
+type GetRegionsResultOutput struct{ *pulumi.OutputState }
+
+func (GetRegionsResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetRegionsResult)(nil)).Elem()
+}
+
+func (o GetRegionsResultOutput) ToGetRegionsResultOutput() GetRegionsResultOutput {
+	return o
+}
+
+func (o GetRegionsResultOutput) ToGetRegionsResultOutputWithContext(ctx context.Context) GetRegionsResultOutput {
+	return o
+}
+
+// The regions Neon can create projects in.
+func (o GetRegionsResultOutput) Regions() RegionArrayOutput {
+	return o.ApplyT(func(v GetRegionsResult) []Region { return v.Regions }).(RegionArrayOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetRegionsResultOutput{})
+}
@@ -1,14 +1,14 @@
-// Code generated by pulumi-language-go DO NOT EDIT.
+// Code generated by pulumi-gen-neon DO NOT EDIT.
 // *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
 
-package xyz
+package neon
 
 import (
 	"fmt"
 
+	"github.com/DonsWayo/pulumi-neon/sdk/go/neon/internal"
 	"github.com/blang/semver"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
-	"internal"
 )
 
 type module struct {
@@ -21,8 +21,18 @@ func (m *module) Version() semver.Version {
 
 func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi.Resource, err error) {
 	switch typ {
-	case "xyz:index:Random":
-		r = &Random{}
+	case "neon:index:Branch":
+		r = &Branch{}
+	case "neon:index:Database":
+		r = &Database{}
+	case "neon:index:Endpoint":
+		r = &Endpoint{}
+	case "neon:index:PreviewDatabase":
+		r = &PreviewDatabase{}
+	case "neon:index:Project":
+		r = &Project{}
+	case "neon:index:Role":
+		r = &Role{}
 	default:
 		return nil, fmt.Errorf("unknown resource type: %s", typ)
 	}
@@ -40,7 +50,7 @@ func (p *pkg) Version() semver.Version {
 }
 
 func (p *pkg) ConstructProvider(ctx *pulumi.Context, name, typ, urn string) (pulumi.ProviderResource, error) {
-	if typ != "pulumi:providers:xyz" {
+	if typ != "pulumi:providers:neon" {
 		return nil, fmt.Errorf("unknown provider type: %s", typ)
 	}
 
@@ -55,12 +65,12 @@ func init() {
 		version = semver.Version{Major: 1}
 	}
 	pulumi.RegisterResourceModule(
-		"xyz",
+		"neon",
 		"index",
 		&module{version},
 	)
 	pulumi.RegisterResourcePackage(
-		"xyz",
+		"neon",
 		&pkg{version},
 	)
 }
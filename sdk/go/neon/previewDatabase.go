@@ -0,0 +1,182 @@
+// Code generated by pulumi-gen-neon DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package neon
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/DonsWayo/pulumi-neon/sdk/go/neon/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// A branch of a Neon project with its own compute endpoint, role and database, for short-lived preview environments.
+type PreviewDatabase struct {
+	pulumi.ResourceState
+
+	// The ID of the preview's branch.
+	BranchId pulumi.StringOutput `pulumi:"branchId"`
+	// When the preview's branch was created, in RFC 3339 format.
+	CreatedAt pulumi.StringOutput `pulumi:"createdAt"`
+	// The database created on the branch.
+	DatabaseName pulumi.StringOutput `pulumi:"databaseName"`
+	// A connection URI for the database as the role.
+	Dsn pulumi.StringOutput `pulumi:"dsn"`
+	// The ID of the preview's read-write endpoint.
+	EndpointId pulumi.StringOutput `pulumi:"endpointId"`
+	// When the preview's ttl runs out, in RFC 3339 format.
+	ExpiresAt pulumi.StringPtrOutput `pulumi:"expiresAt"`
+	// The hostname of the preview's endpoint.
+	Host pulumi.StringOutput `pulumi:"host"`
+	// A connection URI for the database as the role that goes through the connection pooler.
+	PooledDsn pulumi.StringOutput `pulumi:"pooledDsn"`
+	// The role created on the branch.
+	RoleName pulumi.StringOutput `pulumi:"roleName"`
+}
+
+// NewPreviewDatabase registers a new resource with the given unique name, arguments, and options.
+func NewPreviewDatabase(ctx *pulumi.Context,
+	name string, args *PreviewDatabaseArgs, opts ...pulumi.ResourceOption) (*PreviewDatabase, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Name == nil {
+		return nil, errors.New("invalid value for required argument 'Name'")
+	}
+	if args.ProjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ProjectId'")
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"dsn",
+		"pooledDsn",
+	})
+	opts = append(opts, secrets)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource PreviewDatabase
+	err := ctx.RegisterRemoteComponentResource("neon:index:PreviewDatabase", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type previewDatabaseArgs struct {
+	// The database created on the branch. It must not already exist on the parent branch.
+	DatabaseName *string `pulumi:"databaseName"`
+	// The name of the preview's branch.
+	Name string `pulumi:"name"`
+	// The branch the preview is copied from. Defaults to the project's default branch.
+	ParentBranchId *string `pulumi:"parentBranchId"`
+	// The ID of the project to create the preview in.
+	ProjectId string `pulumi:"projectId"`
+	// The role created on the branch. It must not already exist on the parent branch.
+	RoleName *string `pulumi:"roleName"`
+	// How long the preview lives, as a duration such as 72h. Neon deletes the branch once it expires.
+	Ttl *string `pulumi:"ttl"`
+}
+
+// The set of arguments for constructing a PreviewDatabase resource.
+type PreviewDatabaseArgs struct {
+	// The database created on the branch. It must not already exist on the parent branch.
+	DatabaseName pulumi.StringPtrInput
+	// The name of the preview's branch.
+	Name pulumi.StringInput
+	// The branch the preview is copied from. Defaults to the project's default branch.
+	ParentBranchId pulumi.StringPtrInput
+	// The ID of the project to create the preview in.
+	ProjectId pulumi.StringInput
+	// The role created on the branch. It must not already exist on the parent branch.
+	RoleName pulumi.StringPtrInput
+	// How long the preview lives, as a duration such as 72h. Neon deletes the branch once it expires.
+	Ttl pulumi.StringPtrInput
+}
+
+func (PreviewDatabaseArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*previewDatabaseArgs)(nil)).Elem()
+}
+
+type PreviewDatabaseInput interface {
+	pulumi.Input
+
+	ToPreviewDatabaseOutput() PreviewDatabaseOutput
+	ToPreviewDatabaseOutputWithContext(ctx context.Context) PreviewDatabaseOutput
+}
+
+func (*PreviewDatabase) ElementType() reflect.Type {
+	return reflect.TypeOf((**PreviewDatabase)(nil)).Elem()
+}
+
+func (i *PreviewDatabase) ToPreviewDatabaseOutput() PreviewDatabaseOutput {
+	return i.ToPreviewDatabaseOutputWithContext(context.Background())
+}
+
+func (i *PreviewDatabase) ToPreviewDatabaseOutputWithContext(ctx context.Context) PreviewDatabaseOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PreviewDatabaseOutput)
+}
+
+type PreviewDatabaseOutput struct{ *pulumi.OutputState }
+
+func (PreviewDatabaseOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**PreviewDatabase)(nil)).Elem()
+}
+
+func (o PreviewDatabaseOutput) ToPreviewDatabaseOutput() PreviewDatabaseOutput {
+	return o
+}
+
+func (o PreviewDatabaseOutput) ToPreviewDatabaseOutputWithContext(ctx context.Context) PreviewDatabaseOutput {
+	return o
+}
+
+// The ID of the preview's branch.
+func (o PreviewDatabaseOutput) BranchId() pulumi.StringOutput {
+	return o.ApplyT(func(v *PreviewDatabase) pulumi.StringOutput { return v.BranchId }).(pulumi.StringOutput)
+}
+
+// When the preview's branch was created, in RFC 3339 format.
+func (o PreviewDatabaseOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *PreviewDatabase) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+// The database created on the branch.
+func (o PreviewDatabaseOutput) DatabaseName() pulumi.StringOutput {
+	return o.ApplyT(func(v *PreviewDatabase) pulumi.StringOutput { return v.DatabaseName }).(pulumi.StringOutput)
+}
+
+// A connection URI for the database as the role.
+func (o PreviewDatabaseOutput) Dsn() pulumi.StringOutput {
+	return o.ApplyT(func(v *PreviewDatabase) pulumi.StringOutput { return v.Dsn }).(pulumi.StringOutput)
+}
+
+// The ID of the preview's read-write endpoint.
+func (o PreviewDatabaseOutput) EndpointId() pulumi.StringOutput {
+	return o.ApplyT(func(v *PreviewDatabase) pulumi.StringOutput { return v.EndpointId }).(pulumi.StringOutput)
+}
+
+// When the preview's ttl runs out, in RFC 3339 format.
+func (o PreviewDatabaseOutput) ExpiresAt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *PreviewDatabase) pulumi.StringPtrOutput { return v.ExpiresAt }).(pulumi.StringPtrOutput)
+}
+
+// The hostname of the preview's endpoint.
+func (o PreviewDatabaseOutput) Host() pulumi.StringOutput {
+	return o.ApplyT(func(v *PreviewDatabase) pulumi.StringOutput { return v.Host }).(pulumi.StringOutput)
+}
+
+// A connection URI for the database as the role that goes through the connection pooler.
+func (o PreviewDatabaseOutput) PooledDsn() pulumi.StringOutput {
+	return o.ApplyT(func(v *PreviewDatabase) pulumi.StringOutput { return v.PooledDsn }).(pulumi.StringOutput)
+}
+
+// The role created on the branch.
+func (o PreviewDatabaseOutput) RoleName() pulumi.StringOutput {
+	return o.ApplyT(func(v *PreviewDatabase) pulumi.StringOutput { return v.RoleName }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*PreviewDatabaseInput)(nil)).Elem(), &PreviewDatabase{})
+	pulumi.RegisterOutputType(PreviewDatabaseOutput{})
+}
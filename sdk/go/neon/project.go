@@ -0,0 +1,254 @@
+// Code generated by pulumi-gen-neon DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package neon
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/DonsWayo/pulumi-neon/sdk/go/neon/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// A Neon project. Import it with its project ID.
+type Project struct {
+	pulumi.CustomResourceState
+
+	// A connection URI for the default database as the default role. It embeds the role's password.
+	ConnectionUri pulumi.StringPtrOutput `pulumi:"connectionUri"`
+	// When the project was created, in RFC 3339 format.
+	CreatedAt pulumi.StringOutput `pulumi:"createdAt"`
+	// The ID of the branch Neon created along with the project.
+	DefaultBranchId pulumi.StringPtrOutput `pulumi:"defaultBranchId"`
+	// The database Neon created along with the project.
+	DefaultDatabaseName pulumi.StringPtrOutput `pulumi:"defaultDatabaseName"`
+	// The hostname of the read-write endpoint on the default branch.
+	DefaultEndpointHost pulumi.StringPtrOutput `pulumi:"defaultEndpointHost"`
+	// The compute settings applied to endpoints created in the project.
+	DefaultEndpointSettings DefaultEndpointSettingsPtrOutput `pulumi:"defaultEndpointSettings"`
+	// The role Neon created along with the project. It owns the default database.
+	DefaultRoleName pulumi.StringPtrOutput `pulumi:"defaultRoleName"`
+	// How long, in seconds, Neon keeps the history that branches can be created from.
+	HistoryRetentionSeconds pulumi.IntPtrOutput `pulumi:"historyRetentionSeconds"`
+	// The name of the project.
+	Name pulumi.StringOutput `pulumi:"name"`
+	// The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+	OrgId pulumi.StringPtrOutput `pulumi:"orgId"`
+	// The major Postgres version. Changing it replaces the project.
+	PgVersion pulumi.IntPtrOutput `pulumi:"pgVersion"`
+	// The ID Neon assigned to the project.
+	ProjectId pulumi.StringOutput `pulumi:"projectId"`
+	// The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+	Provisioner pulumi.StringPtrOutput `pulumi:"provisioner"`
+	// The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+	RegionId pulumi.StringOutput `pulumi:"regionId"`
+	// Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+	StorePasswords pulumi.BoolPtrOutput `pulumi:"storePasswords"`
+}
+
+// NewProject registers a new resource with the given unique name, arguments, and options.
+func NewProject(ctx *pulumi.Context,
+	name string, args *ProjectArgs, opts ...pulumi.ResourceOption) (*Project, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Name == nil {
+		return nil, errors.New("invalid value for required argument 'Name'")
+	}
+	if args.RegionId == nil {
+		return nil, errors.New("invalid value for required argument 'RegionId'")
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"connectionUri",
+	})
+	opts = append(opts, secrets)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource Project
+	err := ctx.RegisterResource("neon:index:Project", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetProject gets an existing Project resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetProject(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ProjectState, opts ...pulumi.ResourceOption) (*Project, error) {
+	var resource Project
+	err := ctx.ReadResource("neon:index:Project", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Project resources.
+type projectState struct {
+}
+
+type ProjectState struct {
+}
+
+func (ProjectState) ElementType() reflect.Type {
+	return reflect.TypeOf((*projectState)(nil)).Elem()
+}
+
+type projectArgs struct {
+	// The compute settings applied to endpoints created in the project.
+	DefaultEndpointSettings *DefaultEndpointSettings `pulumi:"defaultEndpointSettings"`
+	// How long, in seconds, Neon keeps the history that branches can be created from.
+	HistoryRetentionSeconds *int `pulumi:"historyRetentionSeconds"`
+	// The name of the project.
+	Name string `pulumi:"name"`
+	// The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+	OrgId *string `pulumi:"orgId"`
+	// The major Postgres version. Changing it replaces the project.
+	PgVersion *int `pulumi:"pgVersion"`
+	// The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+	Provisioner *string `pulumi:"provisioner"`
+	// The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+	RegionId string `pulumi:"regionId"`
+	// Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+	StorePasswords *bool `pulumi:"storePasswords"`
+}
+
+// The set of arguments for constructing a Project resource.
+type ProjectArgs struct {
+	// The compute settings applied to endpoints created in the project.
+	DefaultEndpointSettings DefaultEndpointSettingsPtrInput
+	// How long, in seconds, Neon keeps the history that branches can be created from.
+	HistoryRetentionSeconds pulumi.IntPtrInput
+	// The name of the project.
+	Name pulumi.StringInput
+	// The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+	OrgId pulumi.StringPtrInput
+	// The major Postgres version. Changing it replaces the project.
+	PgVersion pulumi.IntPtrInput
+	// The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+	Provisioner pulumi.StringPtrInput
+	// The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+	RegionId pulumi.StringInput
+	// Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+	StorePasswords pulumi.BoolPtrInput
+}
+
+func (ProjectArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*projectArgs)(nil)).Elem()
+}
+
+type ProjectInput interface {
+	pulumi.Input
+
+	ToProjectOutput() ProjectOutput
+	ToProjectOutputWithContext(ctx context.Context) ProjectOutput
+}
+
+func (*Project) ElementType() reflect.Type {
+	return reflect.TypeOf((**Project)(nil)).Elem()
+}
+
+func (i *Project) ToProjectOutput() ProjectOutput {
+	return i.ToProjectOutputWithContext(context.Background())
+}
+
+func (i *Project) ToProjectOutputWithContext(ctx context.Context) ProjectOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProjectOutput)
+}
+
+type ProjectOutput struct{ *pulumi.OutputState }
+
+func (ProjectOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Project)(nil)).Elem()
+}
+
+func (o ProjectOutput) ToProjectOutput() ProjectOutput {
+	return o
+}
+
+func (o ProjectOutput) ToProjectOutputWithContext(ctx context.Context) ProjectOutput {
+	return o
+}
+
+// A connection URI for the default database as the default role. It embeds the role's password.
+func (o ProjectOutput) ConnectionUri() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Project) pulumi.StringPtrOutput { return v.ConnectionUri }).(pulumi.StringPtrOutput)
+}
+
+// When the project was created, in RFC 3339 format.
+func (o ProjectOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *Project) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+// The ID of the branch Neon created along with the project.
+func (o ProjectOutput) DefaultBranchId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Project) pulumi.StringPtrOutput { return v.DefaultBranchId }).(pulumi.StringPtrOutput)
+}
+
+// The database Neon created along with the project.
+func (o ProjectOutput) DefaultDatabaseName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Project) pulumi.StringPtrOutput { return v.DefaultDatabaseName }).(pulumi.StringPtrOutput)
+}
+
+// The hostname of the read-write endpoint on the default branch.
+func (o ProjectOutput) DefaultEndpointHost() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Project) pulumi.StringPtrOutput { return v.DefaultEndpointHost }).(pulumi.StringPtrOutput)
+}
+
+// The compute settings applied to endpoints created in the project.
+func (o ProjectOutput) DefaultEndpointSettings() DefaultEndpointSettingsPtrOutput {
+	return o.ApplyT(func(v *Project) DefaultEndpointSettingsPtrOutput { return v.DefaultEndpointSettings }).(DefaultEndpointSettingsPtrOutput)
+}
+
+// The role Neon created along with the project. It owns the default database.
+func (o ProjectOutput) DefaultRoleName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Project) pulumi.StringPtrOutput { return v.DefaultRoleName }).(pulumi.StringPtrOutput)
+}
+
+// How long, in seconds, Neon keeps the history that branches can be created from.
+func (o ProjectOutput) HistoryRetentionSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Project) pulumi.IntPtrOutput { return v.HistoryRetentionSeconds }).(pulumi.IntPtrOutput)
+}
+
+// The name of the project.
+func (o ProjectOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *Project) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+func (o ProjectOutput) OrgId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Project) pulumi.StringPtrOutput { return v.OrgId }).(pulumi.StringPtrOutput)
+}
+
+// The major Postgres version. Changing it replaces the project.
+func (o ProjectOutput) PgVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Project) pulumi.IntPtrOutput { return v.PgVersion }).(pulumi.IntPtrOutput)
+}
+
+// The ID Neon assigned to the project.
+func (o ProjectOutput) ProjectId() pulumi.StringOutput {
+	return o.ApplyT(func(v *Project) pulumi.StringOutput { return v.ProjectId }).(pulumi.StringOutput)
+}
+
+// The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+func (o ProjectOutput) Provisioner() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Project) pulumi.StringPtrOutput { return v.Provisioner }).(pulumi.StringPtrOutput)
+}
+
+// The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+func (o ProjectOutput) RegionId() pulumi.StringOutput {
+	return o.ApplyT(func(v *Project) pulumi.StringOutput { return v.RegionId }).(pulumi.StringOutput)
+}
+
+// Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+func (o ProjectOutput) StorePasswords() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Project) pulumi.BoolPtrOutput { return v.StorePasswords }).(pulumi.BoolPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*ProjectInput)(nil)).Elem(), &Project{})
+	pulumi.RegisterOutputType(ProjectOutput{})
+}
@@ -0,0 +1,145 @@
+// Code generated by pulumi-gen-neon DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package neon
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/DonsWayo/pulumi-neon/sdk/go/neon/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type Provider struct {
+	pulumi.ProviderResourceState
+
+	// The Neon API key. Defaults to the NEON_API_KEY environment variable.
+	ApiKey pulumi.StringPtrOutput `pulumi:"apiKey"`
+	// The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+	ApiUrl pulumi.StringPtrOutput `pulumi:"apiUrl"`
+	// The organization that projects are created in when they do not set their own orgId.
+	OrgId pulumi.StringPtrOutput `pulumi:"orgId"`
+	// Text appended to the User-Agent header of every request.
+	UserAgentSuffix pulumi.StringPtrOutput `pulumi:"userAgentSuffix"`
+}
+
+// NewProvider registers a new resource with the given unique name, arguments, and options.
+func NewProvider(ctx *pulumi.Context,
+	name string, args *ProviderArgs, opts ...pulumi.ResourceOption) (*Provider, error) {
+	if args == nil {
+		args = &ProviderArgs{}
+	}
+
+	if args.ApiKey != nil {
+		args.ApiKey = pulumi.ToSecret(args.ApiKey).(pulumi.StringPtrInput)
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"apiKey",
+	})
+	opts = append(opts, secrets)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource Provider
+	err := ctx.RegisterResource("pulumi:providers:neon", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type providerArgs struct {
+	// The Neon API key. Defaults to the NEON_API_KEY environment variable.
+	ApiKey *string `pulumi:"apiKey"`
+	// The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+	ApiUrl *string `pulumi:"apiUrl"`
+	// How many times a failed request to the Neon API is retried. Zero disables retries.
+	MaxRetries *int `pulumi:"maxRetries"`
+	// The organization that projects are created in when they do not set their own orgId.
+	OrgId *string `pulumi:"orgId"`
+	// How long a single request to the Neon API may take.
+	RequestTimeoutSeconds *int `pulumi:"requestTimeoutSeconds"`
+	// How long a request keeps retrying while Neon reports it as locked, rate limited or unavailable.
+	RetryTimeoutSeconds *int `pulumi:"retryTimeoutSeconds"`
+	// Text appended to the User-Agent header of every request.
+	UserAgentSuffix *string `pulumi:"userAgentSuffix"`
+}
+
+// The set of arguments for constructing a Provider resource.
+type ProviderArgs struct {
+	// The Neon API key. Defaults to the NEON_API_KEY environment variable.
+	ApiKey pulumi.StringPtrInput
+	// The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+	ApiUrl pulumi.StringPtrInput
+	// How many times a failed request to the Neon API is retried. Zero disables retries.
+	MaxRetries pulumi.IntPtrInput
+	// The organization that projects are created in when they do not set their own orgId.
+	OrgId pulumi.StringPtrInput
+	// How long a single request to the Neon API may take.
+	RequestTimeoutSeconds pulumi.IntPtrInput
+	// How long a request keeps retrying while Neon reports it as locked, rate limited or unavailable.
+	RetryTimeoutSeconds pulumi.IntPtrInput
+	// Text appended to the User-Agent header of every request.
+	UserAgentSuffix pulumi.StringPtrInput
+}
+
+func (ProviderArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*providerArgs)(nil)).Elem()
+}
+
+type ProviderInput interface {
+	pulumi.Input
+
+	ToProviderOutput() ProviderOutput
+	ToProviderOutputWithContext(ctx context.Context) ProviderOutput
+}
+
+func (*Provider) ElementType() reflect.Type {
+	return reflect.TypeOf((**Provider)(nil)).Elem()
+}
+
+func (i *Provider) ToProviderOutput() ProviderOutput {
+	return i.ToProviderOutputWithContext(context.Background())
+}
+
+func (i *Provider) ToProviderOutputWithContext(ctx context.Context) ProviderOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProviderOutput)
+}
+
+type ProviderOutput struct{ *pulumi.OutputState }
+
+func (ProviderOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Provider)(nil)).Elem()
+}
+
+func (o ProviderOutput) ToProviderOutput() ProviderOutput {
+	return o
+}
+
+func (o ProviderOutput) ToProviderOutputWithContext(ctx context.Context) ProviderOutput {
+	return o
+}
+
+// The Neon API key. Defaults to the NEON_API_KEY environment variable.
+func (o ProviderOutput) ApiKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.ApiKey }).(pulumi.StringPtrOutput)
+}
+
+// The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+func (o ProviderOutput) ApiUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.ApiUrl }).(pulumi.StringPtrOutput)
+}
+
+// The organization that projects are created in when they do not set their own orgId.
+func (o ProviderOutput) OrgId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.OrgId }).(pulumi.StringPtrOutput)
+}
+
+// Text appended to the User-Agent header of every request.
+func (o ProviderOutput) UserAgentSuffix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.UserAgentSuffix }).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*ProviderInput)(nil)).Elem(), &Provider{})
+	pulumi.RegisterOutputType(ProviderOutput{})
+}
@@ -0,0 +1,581 @@
+// Code generated by pulumi-gen-neon DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package neon
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/DonsWayo/pulumi-neon/sdk/go/neon/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+var _ = internal.GetEnvOrDefault
+
+// A compute endpoint created along with its branch.
+type BranchEndpoint struct {
+	// The maximum compute size, in compute units.
+	AutoscalingLimitMaxCu *float64 `pulumi:"autoscalingLimitMaxCu"`
+	// The minimum compute size, in compute units.
+	AutoscalingLimitMinCu *float64 `pulumi:"autoscalingLimitMinCu"`
+	// The compute provisioner, k8s-pod or k8s-neonvm.
+	Provisioner *string `pulumi:"provisioner"`
+	// How long, in seconds, an idle endpoint keeps running before it is suspended.
+	SuspendTimeoutSeconds *int `pulumi:"suspendTimeoutSeconds"`
+	// The endpoint type, read_write or read_only.
+	Type string `pulumi:"type"`
+}
+
+// BranchEndpointInput is an input type that accepts BranchEndpointArgs and BranchEndpointOutput values.
+// You can construct a concrete instance of `BranchEndpointInput` via:
+//
+//	BranchEndpointArgs{...}
+type BranchEndpointInput interface {
+	pulumi.Input
+
+	ToBranchEndpointOutput() BranchEndpointOutput
+	ToBranchEndpointOutputWithContext(context.Context) BranchEndpointOutput
+}
+
+// A compute endpoint created along with its branch.
+type BranchEndpointArgs struct {
+	// The maximum compute size, in compute units.
+	AutoscalingLimitMaxCu pulumi.Float64PtrInput `pulumi:"autoscalingLimitMaxCu"`
+	// The minimum compute size, in compute units.
+	AutoscalingLimitMinCu pulumi.Float64PtrInput `pulumi:"autoscalingLimitMinCu"`
+	// The compute provisioner, k8s-pod or k8s-neonvm.
+	Provisioner pulumi.StringPtrInput `pulumi:"provisioner"`
+	// How long, in seconds, an idle endpoint keeps running before it is suspended.
+	SuspendTimeoutSeconds pulumi.IntPtrInput `pulumi:"suspendTimeoutSeconds"`
+	// The endpoint type, read_write or read_only.
+	Type pulumi.StringInput `pulumi:"type"`
+}
+
+func (BranchEndpointArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*BranchEndpoint)(nil)).Elem()
+}
+
+func (i BranchEndpointArgs) ToBranchEndpointOutput() BranchEndpointOutput {
+	return i.ToBranchEndpointOutputWithContext(context.Background())
+}
+
+func (i BranchEndpointArgs) ToBranchEndpointOutputWithContext(ctx context.Context) BranchEndpointOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BranchEndpointOutput)
+}
+
+// BranchEndpointArrayInput is an input type that accepts BranchEndpointArray and BranchEndpointArrayOutput values.
+// You can construct a concrete instance of `BranchEndpointArrayInput` via:
+//
+//	BranchEndpointArray{ BranchEndpointArgs{...} }
+type BranchEndpointArrayInput interface {
+	pulumi.Input
+
+	ToBranchEndpointArrayOutput() BranchEndpointArrayOutput
+	ToBranchEndpointArrayOutputWithContext(context.Context) BranchEndpointArrayOutput
+}
+
+type BranchEndpointArray []BranchEndpointInput
+
+func (BranchEndpointArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]BranchEndpoint)(nil)).Elem()
+}
+
+func (i BranchEndpointArray) ToBranchEndpointArrayOutput() BranchEndpointArrayOutput {
+	return i.ToBranchEndpointArrayOutputWithContext(context.Background())
+}
+
+func (i BranchEndpointArray) ToBranchEndpointArrayOutputWithContext(ctx context.Context) BranchEndpointArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BranchEndpointArrayOutput)
+}
+
+// A compute endpoint created along with its branch.
+type BranchEndpointOutput struct{ *pulumi.OutputState }
+
+func (BranchEndpointOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BranchEndpoint)(nil)).Elem()
+}
+
+func (o BranchEndpointOutput) ToBranchEndpointOutput() BranchEndpointOutput {
+	return o
+}
+
+func (o BranchEndpointOutput) ToBranchEndpointOutputWithContext(ctx context.Context) BranchEndpointOutput {
+	return o
+}
+
+// The maximum compute size, in compute units.
+func (o BranchEndpointOutput) AutoscalingLimitMaxCu() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v BranchEndpoint) *float64 { return v.AutoscalingLimitMaxCu }).(pulumi.Float64PtrOutput)
+}
+
+// The minimum compute size, in compute units.
+func (o BranchEndpointOutput) AutoscalingLimitMinCu() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v BranchEndpoint) *float64 { return v.AutoscalingLimitMinCu }).(pulumi.Float64PtrOutput)
+}
+
+// The compute provisioner, k8s-pod or k8s-neonvm.
+func (o BranchEndpointOutput) Provisioner() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BranchEndpoint) *string { return v.Provisioner }).(pulumi.StringPtrOutput)
+}
+
+// How long, in seconds, an idle endpoint keeps running before it is suspended.
+func (o BranchEndpointOutput) SuspendTimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v BranchEndpoint) *int { return v.SuspendTimeoutSeconds }).(pulumi.IntPtrOutput)
+}
+
+// The endpoint type, read_write or read_only.
+func (o BranchEndpointOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v BranchEndpoint) string { return v.Type }).(pulumi.StringOutput)
+}
+
+type BranchEndpointArrayOutput struct{ *pulumi.OutputState }
+
+func (BranchEndpointArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]BranchEndpoint)(nil)).Elem()
+}
+
+func (o BranchEndpointArrayOutput) ToBranchEndpointArrayOutput() BranchEndpointArrayOutput {
+	return o
+}
+
+func (o BranchEndpointArrayOutput) ToBranchEndpointArrayOutputWithContext(ctx context.Context) BranchEndpointArrayOutput {
+	return o
+}
+
+func (o BranchEndpointArrayOutput) Index(i pulumi.IntInput) BranchEndpointOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) BranchEndpoint {
+		return vs[0].([]BranchEndpoint)[vs[1].(int)]
+	}).(BranchEndpointOutput)
+}
+
+// An endpoint created along with its branch.
+type CreatedEndpoint struct {
+	// The ID Neon assigned to the endpoint.
+	EndpointId string `pulumi:"endpointId"`
+	// The hostname of the endpoint.
+	Host string `pulumi:"host"`
+	// The endpoint type, read_write or read_only.
+	Type string `pulumi:"type"`
+}
+
+// An endpoint created along with its branch.
+type CreatedEndpointOutput struct{ *pulumi.OutputState }
+
+func (CreatedEndpointOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CreatedEndpoint)(nil)).Elem()
+}
+
+func (o CreatedEndpointOutput) ToCreatedEndpointOutput() CreatedEndpointOutput {
+	return o
+}
+
+func (o CreatedEndpointOutput) ToCreatedEndpointOutputWithContext(ctx context.Context) CreatedEndpointOutput {
+	return o
+}
+
+// The ID Neon assigned to the endpoint.
+func (o CreatedEndpointOutput) EndpointId() pulumi.StringOutput {
+	return o.ApplyT(func(v CreatedEndpoint) string { return v.EndpointId }).(pulumi.StringOutput)
+}
+
+// The hostname of the endpoint.
+func (o CreatedEndpointOutput) Host() pulumi.StringOutput {
+	return o.ApplyT(func(v CreatedEndpoint) string { return v.Host }).(pulumi.StringOutput)
+}
+
+// The endpoint type, read_write or read_only.
+func (o CreatedEndpointOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v CreatedEndpoint) string { return v.Type }).(pulumi.StringOutput)
+}
+
+type CreatedEndpointArrayOutput struct{ *pulumi.OutputState }
+
+func (CreatedEndpointArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]CreatedEndpoint)(nil)).Elem()
+}
+
+func (o CreatedEndpointArrayOutput) ToCreatedEndpointArrayOutput() CreatedEndpointArrayOutput {
+	return o
+}
+
+func (o CreatedEndpointArrayOutput) ToCreatedEndpointArrayOutputWithContext(ctx context.Context) CreatedEndpointArrayOutput {
+	return o
+}
+
+func (o CreatedEndpointArrayOutput) Index(i pulumi.IntInput) CreatedEndpointOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) CreatedEndpoint {
+		return vs[0].([]CreatedEndpoint)[vs[1].(int)]
+	}).(CreatedEndpointOutput)
+}
+
+// Compute settings applied to the endpoints created in a project.
+type DefaultEndpointSettings struct {
+	// The maximum compute size, in compute units.
+	AutoscalingLimitMaxCu *float64 `pulumi:"autoscalingLimitMaxCu"`
+	// The minimum compute size, in compute units.
+	AutoscalingLimitMinCu *float64 `pulumi:"autoscalingLimitMinCu"`
+	// How long, in seconds, an idle endpoint keeps running before it is suspended.
+	SuspendTimeoutSeconds *int `pulumi:"suspendTimeoutSeconds"`
+}
+
+// DefaultEndpointSettingsInput is an input type that accepts DefaultEndpointSettingsArgs and DefaultEndpointSettingsOutput values.
+// You can construct a concrete instance of `DefaultEndpointSettingsInput` via:
+//
+//	DefaultEndpointSettingsArgs{...}
+type DefaultEndpointSettingsInput interface {
+	pulumi.Input
+
+	ToDefaultEndpointSettingsOutput() DefaultEndpointSettingsOutput
+	ToDefaultEndpointSettingsOutputWithContext(context.Context) DefaultEndpointSettingsOutput
+}
+
+// Compute settings applied to the endpoints created in a project.
+type DefaultEndpointSettingsArgs struct {
+	// The maximum compute size, in compute units.
+	AutoscalingLimitMaxCu pulumi.Float64PtrInput `pulumi:"autoscalingLimitMaxCu"`
+	// The minimum compute size, in compute units.
+	AutoscalingLimitMinCu pulumi.Float64PtrInput `pulumi:"autoscalingLimitMinCu"`
+	// How long, in seconds, an idle endpoint keeps running before it is suspended.
+	SuspendTimeoutSeconds pulumi.IntPtrInput `pulumi:"suspendTimeoutSeconds"`
+}
+
+func (DefaultEndpointSettingsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*DefaultEndpointSettings)(nil)).Elem()
+}
+
+func (i DefaultEndpointSettingsArgs) ToDefaultEndpointSettingsOutput() DefaultEndpointSettingsOutput {
+	return i.ToDefaultEndpointSettingsOutputWithContext(context.Background())
+}
+
+func (i DefaultEndpointSettingsArgs) ToDefaultEndpointSettingsOutputWithContext(ctx context.Context) DefaultEndpointSettingsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DefaultEndpointSettingsOutput)
+}
+
+func (i DefaultEndpointSettingsArgs) ToDefaultEndpointSettingsPtrOutput() DefaultEndpointSettingsPtrOutput {
+	return i.ToDefaultEndpointSettingsPtrOutputWithContext(context.Background())
+}
+
+func (i DefaultEndpointSettingsArgs) ToDefaultEndpointSettingsPtrOutputWithContext(ctx context.Context) DefaultEndpointSettingsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DefaultEndpointSettingsOutput).ToDefaultEndpointSettingsPtrOutputWithContext(ctx)
+}
+
+// DefaultEndpointSettingsPtrInput is an input type that accepts DefaultEndpointSettingsArgs, DefaultEndpointSettingsPtr and DefaultEndpointSettingsPtrOutput values.
+// You can construct a concrete instance of `DefaultEndpointSettingsPtrInput` via:
+//
+//	        DefaultEndpointSettingsArgs{...}
+//
+//	or:
+//
+//	        nil
+type DefaultEndpointSettingsPtrInput interface {
+	pulumi.Input
+
+	ToDefaultEndpointSettingsPtrOutput() DefaultEndpointSettingsPtrOutput
+	ToDefaultEndpointSettingsPtrOutputWithContext(context.Context) DefaultEndpointSettingsPtrOutput
+}
+
+type defaultEndpointSettingsPtrType DefaultEndpointSettingsArgs
+
+func DefaultEndpointSettingsPtr(v *DefaultEndpointSettingsArgs) DefaultEndpointSettingsPtrInput {
+	return (*defaultEndpointSettingsPtrType)(v)
+}
+
+func (*defaultEndpointSettingsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**DefaultEndpointSettings)(nil)).Elem()
+}
+
+func (i *defaultEndpointSettingsPtrType) ToDefaultEndpointSettingsPtrOutput() DefaultEndpointSettingsPtrOutput {
+	return i.ToDefaultEndpointSettingsPtrOutputWithContext(context.Background())
+}
+
+func (i *defaultEndpointSettingsPtrType) ToDefaultEndpointSettingsPtrOutputWithContext(ctx context.Context) DefaultEndpointSettingsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DefaultEndpointSettingsPtrOutput)
+}
+
+// Compute settings applied to the endpoints created in a project.
+type DefaultEndpointSettingsOutput struct{ *pulumi.OutputState }
+
+func (DefaultEndpointSettingsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DefaultEndpointSettings)(nil)).Elem()
+}
+
+func (o DefaultEndpointSettingsOutput) ToDefaultEndpointSettingsOutput() DefaultEndpointSettingsOutput {
+	return o
+}
+
+func (o DefaultEndpointSettingsOutput) ToDefaultEndpointSettingsOutputWithContext(ctx context.Context) DefaultEndpointSettingsOutput {
+	return o
+}
+
+func (o DefaultEndpointSettingsOutput) ToDefaultEndpointSettingsPtrOutput() DefaultEndpointSettingsPtrOutput {
+	return o.ToDefaultEndpointSettingsPtrOutputWithContext(context.Background())
+}
+
+func (o DefaultEndpointSettingsOutput) ToDefaultEndpointSettingsPtrOutputWithContext(ctx context.Context) DefaultEndpointSettingsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v DefaultEndpointSettings) *DefaultEndpointSettings {
+		return &v
+	}).(DefaultEndpointSettingsPtrOutput)
+}
+
+// The maximum compute size, in compute units.
+func (o DefaultEndpointSettingsOutput) AutoscalingLimitMaxCu() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v DefaultEndpointSettings) *float64 { return v.AutoscalingLimitMaxCu }).(pulumi.Float64PtrOutput)
+}
+
+// The minimum compute size, in compute units.
+func (o DefaultEndpointSettingsOutput) AutoscalingLimitMinCu() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v DefaultEndpointSettings) *float64 { return v.AutoscalingLimitMinCu }).(pulumi.Float64PtrOutput)
+}
+
+// How long, in seconds, an idle endpoint keeps running before it is suspended.
+func (o DefaultEndpointSettingsOutput) SuspendTimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v DefaultEndpointSettings) *int { return v.SuspendTimeoutSeconds }).(pulumi.IntPtrOutput)
+}
+
+type DefaultEndpointSettingsPtrOutput struct{ *pulumi.OutputState }
+
+func (DefaultEndpointSettingsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**DefaultEndpointSettings)(nil)).Elem()
+}
+
+func (o DefaultEndpointSettingsPtrOutput) ToDefaultEndpointSettingsPtrOutput() DefaultEndpointSettingsPtrOutput {
+	return o
+}
+
+func (o DefaultEndpointSettingsPtrOutput) ToDefaultEndpointSettingsPtrOutputWithContext(ctx context.Context) DefaultEndpointSettingsPtrOutput {
+	return o
+}
+
+func (o DefaultEndpointSettingsPtrOutput) Elem() DefaultEndpointSettingsOutput {
+	return o.ApplyT(func(v *DefaultEndpointSettings) DefaultEndpointSettings {
+		if v != nil {
+			return *v
+		}
+		var ret DefaultEndpointSettings
+		return ret
+	}).(DefaultEndpointSettingsOutput)
+}
+
+// The maximum compute size, in compute units.
+func (o DefaultEndpointSettingsPtrOutput) AutoscalingLimitMaxCu() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v *DefaultEndpointSettings) *float64 {
+		if v == nil {
+			return nil
+		}
+		return v.AutoscalingLimitMaxCu
+	}).(pulumi.Float64PtrOutput)
+}
+
+// The minimum compute size, in compute units.
+func (o DefaultEndpointSettingsPtrOutput) AutoscalingLimitMinCu() pulumi.Float64PtrOutput {
+	return o.ApplyT(func(v *DefaultEndpointSettings) *float64 {
+		if v == nil {
+			return nil
+		}
+		return v.AutoscalingLimitMinCu
+	}).(pulumi.Float64PtrOutput)
+}
+
+// How long, in seconds, an idle endpoint keeps running before it is suspended.
+func (o DefaultEndpointSettingsPtrOutput) SuspendTimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *DefaultEndpointSettings) *int {
+		if v == nil {
+			return nil
+		}
+		return v.SuspendTimeoutSeconds
+	}).(pulumi.IntPtrOutput)
+}
+
+// A compute endpoint found by getEndpoints.
+type EndpointSummary struct {
+	// The maximum compute size, in compute units.
+	AutoscalingLimitMaxCu float64 `pulumi:"autoscalingLimitMaxCu"`
+	// The minimum compute size, in compute units.
+	AutoscalingLimitMinCu float64 `pulumi:"autoscalingLimitMinCu"`
+	// When the endpoint was created, in RFC 3339 format.
+	CreatedAt string `pulumi:"createdAt"`
+	// The state of the endpoint's compute, such as active or idle.
+	CurrentState *string `pulumi:"currentState"`
+	// The ID of the endpoint.
+	EndpointId string `pulumi:"endpointId"`
+	// The hostname of the endpoint.
+	Host string `pulumi:"host"`
+	// The hostname that routes connections through PgBouncer.
+	PoolerHost *string `pulumi:"poolerHost"`
+	// The port the endpoint accepts connections on.
+	Port int `pulumi:"port"`
+	// The region of the endpoint.
+	RegionId *string `pulumi:"regionId"`
+	// How long, in seconds, an idle endpoint keeps running before it is suspended.
+	SuspendTimeoutSeconds int `pulumi:"suspendTimeoutSeconds"`
+	// The endpoint type, read_write or read_only.
+	Type string `pulumi:"type"`
+}
+
+// A compute endpoint found by getEndpoints.
+type EndpointSummaryOutput struct{ *pulumi.OutputState }
+
+func (EndpointSummaryOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EndpointSummary)(nil)).Elem()
+}
+
+func (o EndpointSummaryOutput) ToEndpointSummaryOutput() EndpointSummaryOutput {
+	return o
+}
+
+func (o EndpointSummaryOutput) ToEndpointSummaryOutputWithContext(ctx context.Context) EndpointSummaryOutput {
+	return o
+}
+
+// The maximum compute size, in compute units.
+func (o EndpointSummaryOutput) AutoscalingLimitMaxCu() pulumi.Float64Output {
+	return o.ApplyT(func(v EndpointSummary) float64 { return v.AutoscalingLimitMaxCu }).(pulumi.Float64Output)
+}
+
+// The minimum compute size, in compute units.
+func (o EndpointSummaryOutput) AutoscalingLimitMinCu() pulumi.Float64Output {
+	return o.ApplyT(func(v EndpointSummary) float64 { return v.AutoscalingLimitMinCu }).(pulumi.Float64Output)
+}
+
+// When the endpoint was created, in RFC 3339 format.
+func (o EndpointSummaryOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v EndpointSummary) string { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+// The state of the endpoint's compute, such as active or idle.
+func (o EndpointSummaryOutput) CurrentState() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EndpointSummary) *string { return v.CurrentState }).(pulumi.StringPtrOutput)
+}
+
+// The ID of the endpoint.
+func (o EndpointSummaryOutput) EndpointId() pulumi.StringOutput {
+	return o.ApplyT(func(v EndpointSummary) string { return v.EndpointId }).(pulumi.StringOutput)
+}
+
+// The hostname of the endpoint.
+func (o EndpointSummaryOutput) Host() pulumi.StringOutput {
+	return o.ApplyT(func(v EndpointSummary) string { return v.Host }).(pulumi.StringOutput)
+}
+
+// The hostname that routes connections through PgBouncer.
+func (o EndpointSummaryOutput) PoolerHost() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EndpointSummary) *string { return v.PoolerHost }).(pulumi.StringPtrOutput)
+}
+
+// The port the endpoint accepts connections on.
+func (o EndpointSummaryOutput) Port() pulumi.IntOutput {
+	return o.ApplyT(func(v EndpointSummary) int { return v.Port }).(pulumi.IntOutput)
+}
+
+// The region of the endpoint.
+func (o EndpointSummaryOutput) RegionId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EndpointSummary) *string { return v.RegionId }).(pulumi.StringPtrOutput)
+}
+
+// How long, in seconds, an idle endpoint keeps running before it is suspended.
+func (o EndpointSummaryOutput) SuspendTimeoutSeconds() pulumi.IntOutput {
+	return o.ApplyT(func(v EndpointSummary) int { return v.SuspendTimeoutSeconds }).(pulumi.IntOutput)
+}
+
+// The endpoint type, read_write or read_only.
+func (o EndpointSummaryOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v EndpointSummary) string { return v.Type }).(pulumi.StringOutput)
+}
+
+type EndpointSummaryArrayOutput struct{ *pulumi.OutputState }
+
+func (EndpointSummaryArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]EndpointSummary)(nil)).Elem()
+}
+
+func (o EndpointSummaryArrayOutput) ToEndpointSummaryArrayOutput() EndpointSummaryArrayOutput {
+	return o
+}
+
+func (o EndpointSummaryArrayOutput) ToEndpointSummaryArrayOutputWithContext(ctx context.Context) EndpointSummaryArrayOutput {
+	return o
+}
+
+func (o EndpointSummaryArrayOutput) Index(i pulumi.IntInput) EndpointSummaryOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) EndpointSummary {
+		return vs[0].([]EndpointSummary)[vs[1].(int)]
+	}).(EndpointSummaryOutput)
+}
+
+// A region Neon can create projects in.
+type Region struct {
+	// Whether projects are created in this region when they do not set one.
+	Default bool `pulumi:"default"`
+	// The human readable name of the region.
+	Name string `pulumi:"name"`
+	// The ID of the region, such as aws-us-east-2.
+	RegionId string `pulumi:"regionId"`
+}
+
+// A region Neon can create projects in.
+type RegionOutput struct{ *pulumi.OutputState }
+
+func (RegionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Region)(nil)).Elem()
+}
+
+func (o RegionOutput) ToRegionOutput() RegionOutput {
+	return o
+}
+
+func (o RegionOutput) ToRegionOutputWithContext(ctx context.Context) RegionOutput {
+	return o
+}
+
+// Whether projects are created in this region when they do not set one.
+func (o RegionOutput) Default() pulumi.BoolOutput {
+	return o.ApplyT(func(v Region) bool { return v.Default }).(pulumi.BoolOutput)
+}
+
+// The human readable name of the region.
+func (o RegionOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v Region) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The ID of the region, such as aws-us-east-2.
+func (o RegionOutput) RegionId() pulumi.StringOutput {
+	return o.ApplyT(func(v Region) string { return v.RegionId }).(pulumi.StringOutput)
+}
+
+type RegionArrayOutput struct{ *pulumi.OutputState }
+
+func (RegionArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Region)(nil)).Elem()
+}
+
+func (o RegionArrayOutput) ToRegionArrayOutput() RegionArrayOutput {
+	return o
+}
+
+func (o RegionArrayOutput) ToRegionArrayOutputWithContext(ctx context.Context) RegionArrayOutput {
+	return o
+}
+
+func (o RegionArrayOutput) Index(i pulumi.IntInput) RegionOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Region {
+		return vs[0].([]Region)[vs[1].(int)]
+	}).(RegionOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*BranchEndpointInput)(nil)).Elem(), BranchEndpointArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BranchEndpointArrayInput)(nil)).Elem(), BranchEndpointArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DefaultEndpointSettingsInput)(nil)).Elem(), DefaultEndpointSettingsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DefaultEndpointSettingsPtrInput)(nil)).Elem(), DefaultEndpointSettingsArgs{})
+	pulumi.RegisterOutputType(BranchEndpointOutput{})
+	pulumi.RegisterOutputType(BranchEndpointArrayOutput{})
+	pulumi.RegisterOutputType(CreatedEndpointOutput{})
+	pulumi.RegisterOutputType(CreatedEndpointArrayOutput{})
+	pulumi.RegisterOutputType(DefaultEndpointSettingsOutput{})
+	pulumi.RegisterOutputType(DefaultEndpointSettingsPtrOutput{})
+	pulumi.RegisterOutputType(EndpointSummaryOutput{})
+	pulumi.RegisterOutputType(EndpointSummaryArrayOutput{})
+	pulumi.RegisterOutputType(RegionOutput{})
+	pulumi.RegisterOutputType(RegionArrayOutput{})
+}
@@ -0,0 +1,178 @@
+// Code generated by pulumi-gen-neon DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package neon
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/DonsWayo/pulumi-neon/sdk/go/neon/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// A Postgres role on a Neon branch. Import it with an ID of the form projectId/branchId/roleName.
+type Role struct {
+	pulumi.CustomResourceState
+
+	// The ID of the branch the role is on. Changing it replaces the role.
+	BranchId pulumi.StringOutput `pulumi:"branchId"`
+	// When the role was created, in RFC 3339 format.
+	CreatedAt pulumi.StringOutput `pulumi:"createdAt"`
+	// The name of the role. Changing it replaces the role.
+	Name pulumi.StringOutput `pulumi:"name"`
+	// The role's current password, as generated by Neon.
+	Password pulumi.StringPtrOutput `pulumi:"password"`
+	// An arbitrary value. Changing it resets the role's password.
+	PasswordVersion pulumi.StringPtrOutput `pulumi:"passwordVersion"`
+	// The ID of the project the role belongs to. Changing it replaces the role.
+	ProjectId pulumi.StringOutput `pulumi:"projectId"`
+}
+
+// NewRole registers a new resource with the given unique name, arguments, and options.
+func NewRole(ctx *pulumi.Context,
+	name string, args *RoleArgs, opts ...pulumi.ResourceOption) (*Role, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.BranchId == nil {
+		return nil, errors.New("invalid value for required argument 'BranchId'")
+	}
+	if args.Name == nil {
+		return nil, errors.New("invalid value for required argument 'Name'")
+	}
+	if args.ProjectId == nil {
+		return nil, errors.New("invalid value for required argument 'ProjectId'")
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"password",
+	})
+	opts = append(opts, secrets)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource Role
+	err := ctx.RegisterResource("neon:index:Role", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetRole gets an existing Role resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetRole(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *RoleState, opts ...pulumi.ResourceOption) (*Role, error) {
+	var resource Role
+	err := ctx.ReadResource("neon:index:Role", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Role resources.
+type roleState struct {
+}
+
+type RoleState struct {
+}
+
+func (RoleState) ElementType() reflect.Type {
+	return reflect.TypeOf((*roleState)(nil)).Elem()
+}
+
+type roleArgs struct {
+	// The ID of the branch the role is on. Changing it replaces the role.
+	BranchId string `pulumi:"branchId"`
+	// The name of the role. Changing it replaces the role.
+	Name string `pulumi:"name"`
+	// An arbitrary value. Changing it resets the role's password.
+	PasswordVersion *string `pulumi:"passwordVersion"`
+	// The ID of the project the role belongs to. Changing it replaces the role.
+	ProjectId string `pulumi:"projectId"`
+}
+
+// The set of arguments for constructing a Role resource.
+type RoleArgs struct {
+	// The ID of the branch the role is on. Changing it replaces the role.
+	BranchId pulumi.StringInput
+	// The name of the role. Changing it replaces the role.
+	Name pulumi.StringInput
+	// An arbitrary value. Changing it resets the role's password.
+	PasswordVersion pulumi.StringPtrInput
+	// The ID of the project the role belongs to. Changing it replaces the role.
+	ProjectId pulumi.StringInput
+}
+
+func (RoleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*roleArgs)(nil)).Elem()
+}
+
+type RoleInput interface {
+	pulumi.Input
+
+	ToRoleOutput() RoleOutput
+	ToRoleOutputWithContext(ctx context.Context) RoleOutput
+}
+
+func (*Role) ElementType() reflect.Type {
+	return reflect.TypeOf((**Role)(nil)).Elem()
+}
+
+func (i *Role) ToRoleOutput() RoleOutput {
+	return i.ToRoleOutputWithContext(context.Background())
+}
+
+func (i *Role) ToRoleOutputWithContext(ctx context.Context) RoleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RoleOutput)
+}
+
+type RoleOutput struct{ *pulumi.OutputState }
+
+func (RoleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Role)(nil)).Elem()
+}
+
+func (o RoleOutput) ToRoleOutput() RoleOutput {
+	return o
+}
+
+func (o RoleOutput) ToRoleOutputWithContext(ctx context.Context) RoleOutput {
+	return o
+}
+
+// The ID of the branch the role is on. Changing it replaces the role.
+func (o RoleOutput) BranchId() pulumi.StringOutput {
+	return o.ApplyT(func(v *Role) pulumi.StringOutput { return v.BranchId }).(pulumi.StringOutput)
+}
+
+// When the role was created, in RFC 3339 format.
+func (o RoleOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *Role) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+// The name of the role. Changing it replaces the role.
+func (o RoleOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *Role) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// The role's current password, as generated by Neon.
+func (o RoleOutput) Password() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Role) pulumi.StringPtrOutput { return v.Password }).(pulumi.StringPtrOutput)
+}
+
+// An arbitrary value. Changing it resets the role's password.
+func (o RoleOutput) PasswordVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Role) pulumi.StringPtrOutput { return v.PasswordVersion }).(pulumi.StringPtrOutput)
+}
+
+// The ID of the project the role belongs to. Changing it replaces the role.
+func (o RoleOutput) ProjectId() pulumi.StringOutput {
+	return o.ApplyT(func(v *Role) pulumi.StringOutput { return v.ProjectId }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*RoleInput)(nil)).Elem(), &Role{})
+	pulumi.RegisterOutputType(RoleOutput{})
+}
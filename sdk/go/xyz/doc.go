@@ -1,2 +0,0 @@
-// Package xyz exports types, functions, subpackages for provisioning xyz resources.
-package xyz
@@ -1,81 +0,0 @@
-// Code generated by pulumi-language-go DO NOT EDIT.
-// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
-
-package xyz
-
-import (
-	"context"
-	"reflect"
-
-	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
-	"internal"
-)
-
-type Provider struct {
-	pulumi.ProviderResourceState
-}
-
-// NewProvider registers a new resource with the given unique name, arguments, and options.
-func NewProvider(ctx *pulumi.Context,
-	name string, args *ProviderArgs, opts ...pulumi.ResourceOption) (*Provider, error) {
-	if args == nil {
-		args = &ProviderArgs{}
-	}
-
-	opts = internal.PkgResourceDefaultOpts(opts)
-	var resource Provider
-	err := ctx.RegisterResource("pulumi:providers:xyz", name, args, &resource, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &resource, nil
-}
-
-type providerArgs struct {
-}
-
-// The set of arguments for constructing a Provider resource.
-type ProviderArgs struct {
-}
-
-func (ProviderArgs) ElementType() reflect.Type {
-	return reflect.TypeOf((*providerArgs)(nil)).Elem()
-}
-
-type ProviderInput interface {
-	pulumi.Input
-
-	ToProviderOutput() ProviderOutput
-	ToProviderOutputWithContext(ctx context.Context) ProviderOutput
-}
-
-func (*Provider) ElementType() reflect.Type {
-	return reflect.TypeOf((**Provider)(nil)).Elem()
-}
-
-func (i *Provider) ToProviderOutput() ProviderOutput {
-	return i.ToProviderOutputWithContext(context.Background())
-}
-
-func (i *Provider) ToProviderOutputWithContext(ctx context.Context) ProviderOutput {
-	return pulumi.ToOutputWithContext(ctx, i).(ProviderOutput)
-}
-
-type ProviderOutput struct{ *pulumi.OutputState }
-
-func (ProviderOutput) ElementType() reflect.Type {
-	return reflect.TypeOf((**Provider)(nil)).Elem()
-}
-
-func (o ProviderOutput) ToProviderOutput() ProviderOutput {
-	return o
-}
-
-func (o ProviderOutput) ToProviderOutputWithContext(ctx context.Context) ProviderOutput {
-	return o
-}
-
-func init() {
-	pulumi.RegisterInputType(reflect.TypeOf((*ProviderInput)(nil)).Elem(), &Provider{})
-	pulumi.RegisterOutputType(ProviderOutput{})
-}
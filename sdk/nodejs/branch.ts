@@ -1,9 +1,14 @@
-// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
 import * as utilities from "./utilities";
 
+/**
+ * A branch of a Neon project. Import it with an ID of the form projectId/branchId.
+ */
 export class Branch extends pulumi.CustomResource {
     /**
      * Get an existing Branch resource's state with the given name, ID, and optional extra
@@ -31,10 +36,50 @@ export class Branch extends pulumi.CustomResource {
         return obj['__pulumiType'] === Branch.__pulumiType;
     }
 
+    /**
+     * The ID Neon assigned to the branch.
+     */
+    public /*out*/ readonly branchId!: pulumi.Output<string>;
+    /**
+     * When the branch was created, in RFC 3339 format.
+     */
     public /*out*/ readonly createdAt!: pulumi.Output<string>;
-    public /*out*/ readonly id!: pulumi.Output<string>;
+    /**
+     * The endpoints created from endpoints, in the same order. They are deleted along with the branch.
+     */
+    public /*out*/ readonly createdEndpoints!: pulumi.Output<outputs.CreatedEndpoint[] | undefined>;
+    /**
+     * Compute endpoints to create together with the branch. Changing them replaces the branch.
+     */
+    public readonly endpoints!: pulumi.Output<outputs.BranchEndpoint[] | undefined>;
+    /**
+     * The RFC 3339 time at which Neon deletes the branch. Only one of expiresAt and ttl may be set.
+     */
+    public readonly expiresAt!: pulumi.Output<string | undefined>;
+    /**
+     * The name of the branch.
+     */
     public readonly name!: pulumi.Output<string>;
+    /**
+     * The ID of the branch to branch from. Defaults to the project's default branch. Changing it replaces the branch.
+     */
+    public readonly parentId!: pulumi.Output<string | undefined>;
+    /**
+     * Branch from the parent as of this Log Sequence Number. Changing it replaces the branch.
+     */
+    public readonly parentLsn!: pulumi.Output<string | undefined>;
+    /**
+     * Branch from the parent as of this RFC 3339 point in time. Changing it replaces the branch.
+     */
+    public readonly parentTimestamp!: pulumi.Output<string | undefined>;
+    /**
+     * The ID of the project the branch belongs to. Changing it replaces the branch.
+     */
     public readonly projectId!: pulumi.Output<string>;
+    /**
+     * How long after its creation Neon deletes the branch, as a duration such as 72h. Only one of expiresAt and ttl may be set.
+     */
+    public readonly ttl!: pulumi.Output<string | undefined>;
 
     /**
      * Create a Branch resource with the given unique name, arguments, and options.
@@ -53,25 +98,168 @@ export class Branch extends pulumi.CustomResource {
             if ((!args || args.projectId === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'projectId'");
             }
+            resourceInputs["endpoints"] = args ? args.endpoints : undefined;
+            resourceInputs["expiresAt"] = args ? args.expiresAt : undefined;
             resourceInputs["name"] = args ? args.name : undefined;
+            resourceInputs["parentId"] = args ? args.parentId : undefined;
+            resourceInputs["parentLsn"] = args ? args.parentLsn : undefined;
+            resourceInputs["parentTimestamp"] = args ? args.parentTimestamp : undefined;
             resourceInputs["projectId"] = args ? args.projectId : undefined;
+            resourceInputs["ttl"] = args ? args.ttl : undefined;
+            resourceInputs["branchId"] = undefined /*out*/;
             resourceInputs["createdAt"] = undefined /*out*/;
-            resourceInputs["id"] = undefined /*out*/;
+            resourceInputs["createdEndpoints"] = undefined /*out*/;
         } else {
+            resourceInputs["branchId"] = undefined /*out*/;
             resourceInputs["createdAt"] = undefined /*out*/;
-            resourceInputs["id"] = undefined /*out*/;
+            resourceInputs["createdEndpoints"] = undefined /*out*/;
+            resourceInputs["endpoints"] = undefined /*out*/;
+            resourceInputs["expiresAt"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["parentId"] = undefined /*out*/;
+            resourceInputs["parentLsn"] = undefined /*out*/;
+            resourceInputs["parentTimestamp"] = undefined /*out*/;
             resourceInputs["projectId"] = undefined /*out*/;
+            resourceInputs["ttl"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
         super(Branch.__pulumiType, name, resourceInputs, opts);
     }
+
+    /**
+     * Reset the branch to the latest state of its parent, discarding its own changes.
+     */
+    resetToParent(): pulumi.Output<Branch.ResetToParentResult> {
+        return pulumi.runtime.call("neon:index:Branch/resetToParent", {
+            "__self__": this,
+        }, this);
+    }
+
+    /**
+     * Restore the branch's data from a source branch, as of its head, an LSN or a point in time.
+     */
+    restore(args: Branch.RestoreArgs): pulumi.Output<Branch.RestoreResult> {
+        return pulumi.runtime.call("neon:index:Branch/restore", {
+            "__self__": this,
+            "lsn": args.lsn,
+            "preserveUnderName": args.preserveUnderName,
+            "sourceBranchId": args.sourceBranchId,
+            "timestamp": args.timestamp,
+        }, this);
+    }
 }
 
 /**
  * The set of arguments for constructing a Branch resource.
  */
 export interface BranchArgs {
+    /**
+     * Compute endpoints to create together with the branch. Changing them replaces the branch.
+     */
+    endpoints?: pulumi.Input<pulumi.Input<inputs.BranchEndpointArgs>[]>;
+    /**
+     * The RFC 3339 time at which Neon deletes the branch. Only one of expiresAt and ttl may be set.
+     */
+    expiresAt?: pulumi.Input<string>;
+    /**
+     * The name of the branch.
+     */
     name: pulumi.Input<string>;
+    /**
+     * The ID of the branch to branch from. Defaults to the project's default branch. Changing it replaces the branch.
+     */
+    parentId?: pulumi.Input<string>;
+    /**
+     * Branch from the parent as of this Log Sequence Number. Changing it replaces the branch.
+     */
+    parentLsn?: pulumi.Input<string>;
+    /**
+     * Branch from the parent as of this RFC 3339 point in time. Changing it replaces the branch.
+     */
+    parentTimestamp?: pulumi.Input<string>;
+    /**
+     * The ID of the project the branch belongs to. Changing it replaces the branch.
+     */
     projectId: pulumi.Input<string>;
+    /**
+     * How long after its creation Neon deletes the branch, as a duration such as 72h. Only one of expiresAt and ttl may be set.
+     */
+    ttl?: pulumi.Input<string>;
+}
+
+export namespace Branch {
+    /**
+     * The results of the Branch.resetToParent method.
+     */
+    export interface ResetToParentResult {
+        /**
+         * The ID of the branch.
+         */
+        readonly branchId: string;
+        /**
+         * The name of the branch.
+         */
+        readonly name: string;
+        /**
+         * The ID of the branch's parent.
+         */
+        readonly parentId?: string;
+        /**
+         * The Log Sequence Number of the parent the branch now starts from.
+         */
+        readonly parentLsn?: string;
+        /**
+         * The point in time of the parent the branch now starts from.
+         */
+        readonly parentTimestamp?: string;
+    }
+
+    /**
+     * The set of arguments for the Branch.restore method.
+     */
+    export interface RestoreArgs {
+        /**
+         * Restore the source as of this Log Sequence Number. Only one of lsn and timestamp may be set.
+         */
+        lsn?: pulumi.Input<string>;
+        /**
+         * Save the branch's data from before the restore as a new branch with this name.
+         */
+        preserveUnderName?: pulumi.Input<string>;
+        /**
+         * The ID of the branch to restore from.
+         */
+        sourceBranchId: pulumi.Input<string>;
+        /**
+         * Restore the source as of this RFC 3339 point in time. Only one of lsn and timestamp may be set.
+         */
+        timestamp?: pulumi.Input<string>;
+    }
+
+    /**
+     * The results of the Branch.restore method.
+     */
+    export interface RestoreResult {
+        /**
+         * The ID of the branch.
+         */
+        readonly branchId: string;
+        /**
+         * The name of the branch.
+         */
+        readonly name: string;
+        /**
+         * The ID of the branch's parent.
+         */
+        readonly parentId?: string;
+        /**
+         * The Log Sequence Number of the parent the branch now starts from.
+         */
+        readonly parentLsn?: string;
+        /**
+         * The point in time of the parent the branch now starts from.
+         */
+        readonly parentTimestamp?: string;
+    }
+
 }
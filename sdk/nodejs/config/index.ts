@@ -1,4 +1,4 @@
-// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 // Export members:
@@ -1,12 +1,15 @@
-// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
-import * as utilities from "../utilities";
+import * as utilities from "./utilities";
 
 declare var exports: any;
 const __config = new pulumi.Config("neon");
 
+/**
+ * The Neon API key. Defaults to the NEON_API_KEY environment variable.
+ */
 export declare const apiKey: string | undefined;
 Object.defineProperty(exports, "apiKey", {
     get() {
@@ -15,3 +18,69 @@ Object.defineProperty(exports, "apiKey", {
     enumerable: true,
 });
 
+/**
+ * The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+ */
+export declare const apiUrl: string | undefined;
+Object.defineProperty(exports, "apiUrl", {
+    get() {
+        return __config.get("apiUrl");
+    },
+    enumerable: true,
+});
+
+/**
+ * How many times a failed request to the Neon API is retried. Zero disables retries.
+ */
+export declare const maxRetries: number | undefined;
+Object.defineProperty(exports, "maxRetries", {
+    get() {
+        return __config.getObject<number>("maxRetries");
+    },
+    enumerable: true,
+});
+
+/**
+ * The organization that projects are created in when they do not set their own orgId.
+ */
+export declare const orgId: string | undefined;
+Object.defineProperty(exports, "orgId", {
+    get() {
+        return __config.get("orgId");
+    },
+    enumerable: true,
+});
+
+/**
+ * How long a single request to the Neon API may take.
+ */
+export declare const requestTimeoutSeconds: number | undefined;
+Object.defineProperty(exports, "requestTimeoutSeconds", {
+    get() {
+        return __config.getObject<number>("requestTimeoutSeconds");
+    },
+    enumerable: true,
+});
+
+/**
+ * How long a request keeps retrying while Neon reports it as locked, rate limited or unavailable.
+ */
+export declare const retryTimeoutSeconds: number | undefined;
+Object.defineProperty(exports, "retryTimeoutSeconds", {
+    get() {
+        return __config.getObject<number>("retryTimeoutSeconds");
+    },
+    enumerable: true,
+});
+
+/**
+ * Text appended to the User-Agent header of every request.
+ */
+export declare const userAgentSuffix: string | undefined;
+Object.defineProperty(exports, "userAgentSuffix", {
+    get() {
+        return __config.get("userAgentSuffix");
+    },
+    enumerable: true,
+});
+
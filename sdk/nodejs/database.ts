@@ -1,9 +1,12 @@
-// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
+/**
+ * A Postgres database on a Neon branch. Import it with an ID of the form projectId/branchId/databaseId, where the database's name may be given in place of its ID.
+ */
 export class Database extends pulumi.CustomResource {
     /**
      * Get an existing Database resource's state with the given name, ID, and optional extra
@@ -31,10 +34,29 @@ export class Database extends pulumi.CustomResource {
         return obj['__pulumiType'] === Database.__pulumiType;
     }
 
+    /**
+     * The ID of the branch the database is on. Changing it replaces the database.
+     */
     public readonly branchId!: pulumi.Output<string>;
+    /**
+     * When the database was created, in RFC 3339 format.
+     */
     public /*out*/ readonly createdAt!: pulumi.Output<string>;
-    public /*out*/ readonly id!: pulumi.Output<string>;
+    /**
+     * The ID Neon assigned to the database.
+     */
+    public /*out*/ readonly databaseId!: pulumi.Output<string>;
+    /**
+     * The name of the database.
+     */
     public readonly name!: pulumi.Output<string>;
+    /**
+     * The role that owns the database, typically the name of a Role resource.
+     */
+    public readonly ownerName!: pulumi.Output<string>;
+    /**
+     * The ID of the project the database belongs to. Changing it replaces the database.
+     */
     public readonly projectId!: pulumi.Output<string>;
 
     /**
@@ -54,19 +76,24 @@ export class Database extends pulumi.CustomResource {
             if ((!args || args.name === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'name'");
             }
+            if ((!args || args.ownerName === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'ownerName'");
+            }
             if ((!args || args.projectId === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'projectId'");
             }
             resourceInputs["branchId"] = args ? args.branchId : undefined;
             resourceInputs["name"] = args ? args.name : undefined;
+            resourceInputs["ownerName"] = args ? args.ownerName : undefined;
             resourceInputs["projectId"] = args ? args.projectId : undefined;
             resourceInputs["createdAt"] = undefined /*out*/;
-            resourceInputs["id"] = undefined /*out*/;
+            resourceInputs["databaseId"] = undefined /*out*/;
         } else {
             resourceInputs["branchId"] = undefined /*out*/;
             resourceInputs["createdAt"] = undefined /*out*/;
-            resourceInputs["id"] = undefined /*out*/;
+            resourceInputs["databaseId"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["ownerName"] = undefined /*out*/;
             resourceInputs["projectId"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
@@ -78,7 +105,20 @@ export class Database extends pulumi.CustomResource {
  * The set of arguments for constructing a Database resource.
  */
 export interface DatabaseArgs {
+    /**
+     * The ID of the branch the database is on. Changing it replaces the database.
+     */
     branchId: pulumi.Input<string>;
+    /**
+     * The name of the database.
+     */
     name: pulumi.Input<string>;
+    /**
+     * The role that owns the database, typically the name of a Role resource.
+     */
+    ownerName: pulumi.Input<string>;
+    /**
+     * The ID of the project the database belongs to. Changing it replaces the database.
+     */
     projectId: pulumi.Input<string>;
 }
@@ -1,9 +1,12 @@
-// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
+/**
+ * A compute endpoint on a Neon branch. Import it with an ID of the form projectId/endpointId.
+ */
 export class Endpoint extends pulumi.CustomResource {
     /**
      * Get an existing Endpoint resource's state with the given name, ID, and optional extra
@@ -31,11 +34,97 @@ export class Endpoint extends pulumi.CustomResource {
         return obj['__pulumiType'] === Endpoint.__pulumiType;
     }
 
+    /**
+     * The maximum compute size, in compute units.
+     */
+    public readonly autoscalingLimitMaxCu!: pulumi.Output<number | undefined>;
+    /**
+     * The minimum compute size, in compute units.
+     */
+    public readonly autoscalingLimitMinCu!: pulumi.Output<number | undefined>;
+    /**
+     * The ID of the branch the endpoint serves. Changing it moves the endpoint to that branch.
+     */
     public readonly branchId!: pulumi.Output<string>;
+    /**
+     * A connection URI for databaseName as roleName. It embeds the role's password.
+     */
+    public /*out*/ readonly connectionUri!: pulumi.Output<string | undefined>;
+    /**
+     * When the endpoint was created, in RFC 3339 format.
+     */
     public /*out*/ readonly createdAt!: pulumi.Output<string>;
+    /**
+     * The state of the endpoint's compute, such as active or idle.
+     */
+    public /*out*/ readonly currentState!: pulumi.Output<string | undefined>;
+    /**
+     * The database the connection URIs connect to. The URIs are only filled in when roleName is set too.
+     */
+    public readonly databaseName!: pulumi.Output<string | undefined>;
+    /**
+     * The ID Neon assigned to the endpoint.
+     */
+    public /*out*/ readonly endpointId!: pulumi.Output<string>;
+    /**
+     * The hostname of the endpoint.
+     */
     public /*out*/ readonly host!: pulumi.Output<string>;
-    public /*out*/ readonly id!: pulumi.Output<string>;
+    /**
+     * When the endpoint was last active, in RFC 3339 format.
+     */
+    public /*out*/ readonly lastActive!: pulumi.Output<string | undefined>;
+    /**
+     * A connection URI for databaseName as roleName that goes through the connection pooler. It embeds the role's password.
+     */
+    public /*out*/ readonly pooledConnectionUri!: pulumi.Output<string | undefined>;
+    /**
+     * Whether connections can go through the PgBouncer connection pooler.
+     */
+    public readonly poolerEnabled!: pulumi.Output<boolean | undefined>;
+    /**
+     * The hostname that routes connections through PgBouncer.
+     */
+    public /*out*/ readonly poolerHost!: pulumi.Output<string | undefined>;
+    /**
+     * The connection pooler mode, such as transaction.
+     */
+    public readonly poolerMode!: pulumi.Output<string | undefined>;
+    /**
+     * The port the endpoint accepts connections on.
+     */
+    public /*out*/ readonly port!: pulumi.Output<number | undefined>;
+    /**
+     * The ID of the project the endpoint belongs to. Changing it replaces the endpoint.
+     */
     public readonly projectId!: pulumi.Output<string>;
+    /**
+     * The compute provisioner, k8s-pod or k8s-neonvm.
+     */
+    public readonly provisioner!: pulumi.Output<string | undefined>;
+    /**
+     * The hostname of the proxy in front of the endpoint.
+     */
+    public /*out*/ readonly proxyHost!: pulumi.Output<string | undefined>;
+    /**
+     * The region of the endpoint, which must match the project's region. Changing it replaces the endpoint.
+     */
+    public readonly regionId!: pulumi.Output<string | undefined>;
+    /**
+     * The role whose credentials the connection URIs use. The URIs are only filled in when databaseName is set too.
+     */
+    public readonly roleName!: pulumi.Output<string | undefined>;
+    /**
+     * Postgres settings applied to the endpoint's compute.
+     */
+    public readonly settings!: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * How long, in seconds, an idle endpoint keeps running before it is suspended.
+     */
+    public readonly suspendTimeoutSeconds!: pulumi.Output<number | undefined>;
+    /**
+     * The endpoint type, read_write or read_only. Changing it replaces the endpoint.
+     */
     public readonly type!: pulumi.Output<string>;
 
     /**
@@ -58,21 +147,57 @@ export class Endpoint extends pulumi.CustomResource {
             if ((!args || args.type === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'type'");
             }
+            resourceInputs["autoscalingLimitMaxCu"] = args ? args.autoscalingLimitMaxCu : undefined;
+            resourceInputs["autoscalingLimitMinCu"] = args ? args.autoscalingLimitMinCu : undefined;
             resourceInputs["branchId"] = args ? args.branchId : undefined;
+            resourceInputs["databaseName"] = args ? args.databaseName : undefined;
+            resourceInputs["poolerEnabled"] = args ? args.poolerEnabled : undefined;
+            resourceInputs["poolerMode"] = args ? args.poolerMode : undefined;
             resourceInputs["projectId"] = args ? args.projectId : undefined;
+            resourceInputs["provisioner"] = args ? args.provisioner : undefined;
+            resourceInputs["regionId"] = args ? args.regionId : undefined;
+            resourceInputs["roleName"] = args ? args.roleName : undefined;
+            resourceInputs["settings"] = args ? args.settings : undefined;
+            resourceInputs["suspendTimeoutSeconds"] = args ? args.suspendTimeoutSeconds : undefined;
             resourceInputs["type"] = args ? args.type : undefined;
+            resourceInputs["connectionUri"] = undefined /*out*/;
             resourceInputs["createdAt"] = undefined /*out*/;
+            resourceInputs["currentState"] = undefined /*out*/;
+            resourceInputs["endpointId"] = undefined /*out*/;
             resourceInputs["host"] = undefined /*out*/;
-            resourceInputs["id"] = undefined /*out*/;
+            resourceInputs["lastActive"] = undefined /*out*/;
+            resourceInputs["pooledConnectionUri"] = undefined /*out*/;
+            resourceInputs["poolerHost"] = undefined /*out*/;
+            resourceInputs["port"] = undefined /*out*/;
+            resourceInputs["proxyHost"] = undefined /*out*/;
         } else {
+            resourceInputs["autoscalingLimitMaxCu"] = undefined /*out*/;
+            resourceInputs["autoscalingLimitMinCu"] = undefined /*out*/;
             resourceInputs["branchId"] = undefined /*out*/;
+            resourceInputs["connectionUri"] = undefined /*out*/;
             resourceInputs["createdAt"] = undefined /*out*/;
+            resourceInputs["currentState"] = undefined /*out*/;
+            resourceInputs["databaseName"] = undefined /*out*/;
+            resourceInputs["endpointId"] = undefined /*out*/;
             resourceInputs["host"] = undefined /*out*/;
-            resourceInputs["id"] = undefined /*out*/;
+            resourceInputs["lastActive"] = undefined /*out*/;
+            resourceInputs["pooledConnectionUri"] = undefined /*out*/;
+            resourceInputs["poolerEnabled"] = undefined /*out*/;
+            resourceInputs["poolerHost"] = undefined /*out*/;
+            resourceInputs["poolerMode"] = undefined /*out*/;
+            resourceInputs["port"] = undefined /*out*/;
             resourceInputs["projectId"] = undefined /*out*/;
+            resourceInputs["provisioner"] = undefined /*out*/;
+            resourceInputs["proxyHost"] = undefined /*out*/;
+            resourceInputs["regionId"] = undefined /*out*/;
+            resourceInputs["roleName"] = undefined /*out*/;
+            resourceInputs["settings"] = undefined /*out*/;
+            resourceInputs["suspendTimeoutSeconds"] = undefined /*out*/;
             resourceInputs["type"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["connectionUri", "pooledConnectionUri"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
         super(Endpoint.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -81,7 +206,56 @@ export class Endpoint extends pulumi.CustomResource {
  * The set of arguments for constructing a Endpoint resource.
  */
 export interface EndpointArgs {
+    /**
+     * The maximum compute size, in compute units.
+     */
+    autoscalingLimitMaxCu?: pulumi.Input<number>;
+    /**
+     * The minimum compute size, in compute units.
+     */
+    autoscalingLimitMinCu?: pulumi.Input<number>;
+    /**
+     * The ID of the branch the endpoint serves. Changing it moves the endpoint to that branch.
+     */
     branchId: pulumi.Input<string>;
+    /**
+     * The database the connection URIs connect to. The URIs are only filled in when roleName is set too.
+     */
+    databaseName?: pulumi.Input<string>;
+    /**
+     * Whether connections can go through the PgBouncer connection pooler.
+     */
+    poolerEnabled?: pulumi.Input<boolean>;
+    /**
+     * The connection pooler mode, such as transaction.
+     */
+    poolerMode?: pulumi.Input<string>;
+    /**
+     * The ID of the project the endpoint belongs to. Changing it replaces the endpoint.
+     */
     projectId: pulumi.Input<string>;
+    /**
+     * The compute provisioner, k8s-pod or k8s-neonvm.
+     */
+    provisioner?: pulumi.Input<string>;
+    /**
+     * The region of the endpoint, which must match the project's region. Changing it replaces the endpoint.
+     */
+    regionId?: pulumi.Input<string>;
+    /**
+     * The role whose credentials the connection URIs use. The URIs are only filled in when databaseName is set too.
+     */
+    roleName?: pulumi.Input<string>;
+    /**
+     * Postgres settings applied to the endpoint's compute.
+     */
+    settings?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * How long, in seconds, an idle endpoint keeps running before it is suspended.
+     */
+    suspendTimeoutSeconds?: pulumi.Input<number>;
+    /**
+     * The endpoint type, read_write or read_only. Changing it replaces the endpoint.
+     */
     type: pulumi.Input<string>;
 }
@@ -0,0 +1,76 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Look up a branch of a Neon project by its name.
+ */
+export function getBranch(args: GetBranchArgs, opts?: pulumi.InvokeOptions): Promise<GetBranchResult> {
+
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("neon:index:getBranch", {
+        "name": args.name,
+        "projectId": args.projectId,
+    }, opts);
+}
+
+export interface GetBranchArgs {
+    /**
+     * The name of the branch to look up.
+     */
+    name: string;
+    /**
+     * The ID of the project the branch belongs to.
+     */
+    projectId: string;
+}
+
+export interface GetBranchResult {
+    /**
+     * The ID of the branch.
+     */
+    readonly branchId: string;
+    /**
+     * When the branch was created, in RFC 3339 format.
+     */
+    readonly createdAt: string;
+    /**
+     * The name of the branch.
+     */
+    readonly name: string;
+    /**
+     * The ID of the branch it was branched from.
+     */
+    readonly parentId?: string;
+    /**
+     * The Log Sequence Number of the parent it was branched from.
+     */
+    readonly parentLsn?: string;
+    /**
+     * The point in time of the parent it was branched from.
+     */
+    readonly parentTimestamp?: string;
+    /**
+     * The ID of the project the branch belongs to.
+     */
+    readonly projectId: string;
+}
+/**
+ * Look up a branch of a Neon project by its name.
+ */
+export function getBranchOutput(args: GetBranchOutputArgs, opts?: pulumi.InvokeOptions): pulumi.Output<GetBranchResult> {
+    return pulumi.output(args).apply((a: any) => getBranch(a, opts))
+}
+
+export interface GetBranchOutputArgs {
+    /**
+     * The name of the branch to look up.
+     */
+    name: pulumi.Input<string>;
+    /**
+     * The ID of the project the branch belongs to.
+     */
+    projectId: pulumi.Input<string>;
+}
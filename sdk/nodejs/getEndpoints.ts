@@ -0,0 +1,54 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * List the compute endpoints on a branch of a Neon project.
+ */
+export function getEndpoints(args: GetEndpointsArgs, opts?: pulumi.InvokeOptions): Promise<GetEndpointsResult> {
+
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("neon:index:getEndpoints", {
+        "branchId": args.branchId,
+        "projectId": args.projectId,
+    }, opts);
+}
+
+export interface GetEndpointsArgs {
+    /**
+     * The ID of the branch whose endpoints are listed.
+     */
+    branchId: string;
+    /**
+     * The ID of the project the branch belongs to.
+     */
+    projectId: string;
+}
+
+export interface GetEndpointsResult {
+    /**
+     * The endpoints on the branch.
+     */
+    readonly endpoints: outputs.EndpointSummary[];
+}
+/**
+ * List the compute endpoints on a branch of a Neon project.
+ */
+export function getEndpointsOutput(args: GetEndpointsOutputArgs, opts?: pulumi.InvokeOptions): pulumi.Output<GetEndpointsResult> {
+    return pulumi.output(args).apply((a: any) => getEndpoints(a, opts))
+}
+
+export interface GetEndpointsOutputArgs {
+    /**
+     * The ID of the branch whose endpoints are listed.
+     */
+    branchId: pulumi.Input<string>;
+    /**
+     * The ID of the project the branch belongs to.
+     */
+    projectId: pulumi.Input<string>;
+}
@@ -0,0 +1,100 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Look up a Neon project by its ID or, within an organization, by its name.
+ */
+export function getProject(args?: GetProjectArgs, opts?: pulumi.InvokeOptions): Promise<GetProjectResult> {
+    args = args || {};
+
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("neon:index:getProject", {
+        "name": args.name,
+        "orgId": args.orgId,
+        "projectId": args.projectId,
+    }, opts);
+}
+
+export interface GetProjectArgs {
+    /**
+     * The name of the project to look up. Exactly one of projectId and name must be set.
+     */
+    name?: string;
+    /**
+     * The organization searched for a project by name. Defaults to the provider's orgId.
+     */
+    orgId?: string;
+    /**
+     * The ID of the project to look up. Exactly one of projectId and name must be set.
+     */
+    projectId?: string;
+}
+
+export interface GetProjectResult {
+    /**
+     * When the project was created, in RFC 3339 format.
+     */
+    readonly createdAt: string;
+    /**
+     * The compute settings applied to endpoints created in the project.
+     */
+    readonly defaultEndpointSettings?: outputs.DefaultEndpointSettings;
+    /**
+     * How long, in seconds, Neon keeps the history that branches can be created from.
+     */
+    readonly historyRetentionSeconds?: number;
+    /**
+     * The name of the project.
+     */
+    readonly name: string;
+    /**
+     * The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+     */
+    readonly orgId?: string;
+    /**
+     * The major Postgres version. Changing it replaces the project.
+     */
+    readonly pgVersion?: number;
+    /**
+     * The ID of the project.
+     */
+    readonly projectId: string;
+    /**
+     * The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+     */
+    readonly provisioner?: string;
+    /**
+     * The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+     */
+    readonly regionId: string;
+    /**
+     * Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+     */
+    readonly storePasswords?: boolean;
+}
+/**
+ * Look up a Neon project by its ID or, within an organization, by its name.
+ */
+export function getProjectOutput(args?: GetProjectOutputArgs, opts?: pulumi.InvokeOptions): pulumi.Output<GetProjectResult> {
+    return pulumi.output(args).apply((a: any) => getProject(a, opts))
+}
+
+export interface GetProjectOutputArgs {
+    /**
+     * The name of the project to look up. Exactly one of projectId and name must be set.
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * The organization searched for a project by name. Defaults to the provider's orgId.
+     */
+    orgId?: pulumi.Input<string>;
+    /**
+     * The ID of the project to look up. Exactly one of projectId and name must be set.
+     */
+    projectId?: pulumi.Input<string>;
+}
@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * List the regions Neon can create projects in.
+ */
+export function getRegions(args?: GetRegionsArgs, opts?: pulumi.InvokeOptions): Promise<GetRegionsResult> {
+    args = args || {};
+
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("neon:index:getRegions", {
+    }, opts);
+}
+
+export interface GetRegionsArgs {
+}
+
+export interface GetRegionsResult {
+    /**
+     * The regions Neon can create projects in.
+     */
+    readonly regions: outputs.Region[];
+}
+/**
+ * List the regions Neon can create projects in.
+ */
+export function getRegionsOutput(opts?: pulumi.InvokeOptions): pulumi.Output<GetRegionsResult> {
+    return pulumi.output(getRegions(opts))
+}
@@ -1,14 +1,12 @@
-// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
 // Export members:
-export { BranchArgs } from "./branch";
-export type Branch = import("./branch").Branch;
-export const Branch: typeof import("./branch").Branch = null as any;
-utilities.lazyLoad(exports, ["Branch"], () => require("./branch"));
+export * from "./branch";
+import { Branch } from "./branch";
 
 export { DatabaseArgs } from "./database";
 export type Database = import("./database").Database;
@@ -20,6 +18,31 @@ export type Endpoint = import("./endpoint").Endpoint;
 export const Endpoint: typeof import("./endpoint").Endpoint = null as any;
 utilities.lazyLoad(exports, ["Endpoint"], () => require("./endpoint"));
 
+export { GetBranchArgs, GetBranchResult, GetBranchOutputArgs } from "./getBranch";
+export const getBranch: typeof import("./getBranch").getBranch = null as any;
+export const getBranchOutput: typeof import("./getBranch").getBranchOutput = null as any;
+utilities.lazyLoad(exports, ["getBranch","getBranchOutput"], () => require("./getBranch"));
+
+export { GetEndpointsArgs, GetEndpointsResult, GetEndpointsOutputArgs } from "./getEndpoints";
+export const getEndpoints: typeof import("./getEndpoints").getEndpoints = null as any;
+export const getEndpointsOutput: typeof import("./getEndpoints").getEndpointsOutput = null as any;
+utilities.lazyLoad(exports, ["getEndpoints","getEndpointsOutput"], () => require("./getEndpoints"));
+
+export { GetProjectArgs, GetProjectResult, GetProjectOutputArgs } from "./getProject";
+export const getProject: typeof import("./getProject").getProject = null as any;
+export const getProjectOutput: typeof import("./getProject").getProjectOutput = null as any;
+utilities.lazyLoad(exports, ["getProject","getProjectOutput"], () => require("./getProject"));
+
+export { GetRegionsArgs, GetRegionsResult } from "./getRegions";
+export const getRegions: typeof import("./getRegions").getRegions = null as any;
+export const getRegionsOutput: typeof import("./getRegions").getRegionsOutput = null as any;
+utilities.lazyLoad(exports, ["getRegions","getRegionsOutput"], () => require("./getRegions"));
+
+export { PreviewDatabaseArgs } from "./previewDatabase";
+export type PreviewDatabase = import("./previewDatabase").PreviewDatabase;
+export const PreviewDatabase: typeof import("./previewDatabase").PreviewDatabase = null as any;
+utilities.lazyLoad(exports, ["PreviewDatabase"], () => require("./previewDatabase"));
+
 export { ProjectArgs } from "./project";
 export type Project = import("./project").Project;
 export const Project: typeof import("./project").Project = null as any;
@@ -38,9 +61,11 @@ utilities.lazyLoad(exports, ["Role"], () => require("./role"));
 
 // Export sub-modules:
 import * as config from "./config";
+import * as types from "./types";
 
 export {
     config,
+    types,
 };
 
 const _module = {
@@ -53,6 +78,8 @@ const _module = {
                 return new Database(name, <any>undefined, { urn })
             case "neon:index:Endpoint":
                 return new Endpoint(name, <any>undefined, { urn })
+            case "neon:index:PreviewDatabase":
+                return new PreviewDatabase(name, <any>undefined, { urn })
             case "neon:index:Project":
                 return new Project(name, <any>undefined, { urn })
             case "neon:index:Role":
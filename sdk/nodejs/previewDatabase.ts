@@ -0,0 +1,138 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A branch of a Neon project with its own compute endpoint, role and database, for short-lived preview environments.
+ */
+export class PreviewDatabase extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'neon:index:PreviewDatabase';
+
+    /**
+     * Returns true if the given object is an instance of PreviewDatabase.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is PreviewDatabase {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === PreviewDatabase.__pulumiType;
+    }
+
+    /**
+     * The ID of the preview's branch.
+     */
+    public /*out*/ readonly branchId!: pulumi.Output<string>;
+    /**
+     * When the preview's branch was created, in RFC 3339 format.
+     */
+    public /*out*/ readonly createdAt!: pulumi.Output<string>;
+    /**
+     * The database created on the branch.
+     */
+    public readonly databaseName!: pulumi.Output<string>;
+    /**
+     * A connection URI for the database as the role.
+     */
+    public /*out*/ readonly dsn!: pulumi.Output<string>;
+    /**
+     * The ID of the preview's read-write endpoint.
+     */
+    public /*out*/ readonly endpointId!: pulumi.Output<string>;
+    /**
+     * When the preview's ttl runs out, in RFC 3339 format.
+     */
+    public /*out*/ readonly expiresAt!: pulumi.Output<string | undefined>;
+    /**
+     * The hostname of the preview's endpoint.
+     */
+    public /*out*/ readonly host!: pulumi.Output<string>;
+    /**
+     * A connection URI for the database as the role that goes through the connection pooler.
+     */
+    public /*out*/ readonly pooledDsn!: pulumi.Output<string>;
+    /**
+     * The role created on the branch.
+     */
+    public readonly roleName!: pulumi.Output<string>;
+
+    /**
+     * Create a PreviewDatabase resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: PreviewDatabaseArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.name === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'name'");
+            }
+            if ((!args || args.projectId === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'projectId'");
+            }
+            resourceInputs["databaseName"] = args ? args.databaseName : undefined;
+            resourceInputs["name"] = args ? args.name : undefined;
+            resourceInputs["parentBranchId"] = args ? args.parentBranchId : undefined;
+            resourceInputs["projectId"] = args ? args.projectId : undefined;
+            resourceInputs["roleName"] = args ? args.roleName : undefined;
+            resourceInputs["ttl"] = args ? args.ttl : undefined;
+            resourceInputs["branchId"] = undefined /*out*/;
+            resourceInputs["createdAt"] = undefined /*out*/;
+            resourceInputs["dsn"] = undefined /*out*/;
+            resourceInputs["endpointId"] = undefined /*out*/;
+            resourceInputs["expiresAt"] = undefined /*out*/;
+            resourceInputs["host"] = undefined /*out*/;
+            resourceInputs["pooledDsn"] = undefined /*out*/;
+        } else {
+            resourceInputs["branchId"] = undefined /*out*/;
+            resourceInputs["createdAt"] = undefined /*out*/;
+            resourceInputs["databaseName"] = undefined /*out*/;
+            resourceInputs["dsn"] = undefined /*out*/;
+            resourceInputs["endpointId"] = undefined /*out*/;
+            resourceInputs["expiresAt"] = undefined /*out*/;
+            resourceInputs["host"] = undefined /*out*/;
+            resourceInputs["pooledDsn"] = undefined /*out*/;
+            resourceInputs["roleName"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["dsn", "pooledDsn"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(PreviewDatabase.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a PreviewDatabase resource.
+ */
+export interface PreviewDatabaseArgs {
+    /**
+     * The database created on the branch. It must not already exist on the parent branch.
+     */
+    databaseName?: pulumi.Input<string>;
+    /**
+     * The name of the preview's branch.
+     */
+    name: pulumi.Input<string>;
+    /**
+     * The branch the preview is copied from. Defaults to the project's default branch.
+     */
+    parentBranchId?: pulumi.Input<string>;
+    /**
+     * The ID of the project to create the preview in.
+     */
+    projectId: pulumi.Input<string>;
+    /**
+     * The role created on the branch. It must not already exist on the parent branch.
+     */
+    roleName?: pulumi.Input<string>;
+    /**
+     * How long the preview lives, as a duration such as 72h. Neon deletes the branch once it expires.
+     */
+    ttl?: pulumi.Input<string>;
+}
@@ -1,9 +1,14 @@
-// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
 import * as utilities from "./utilities";
 
+/**
+ * A Neon project. Import it with its project ID.
+ */
 export class Project extends pulumi.CustomResource {
     /**
      * Get an existing Project resource's state with the given name, ID, and optional extra
@@ -31,10 +36,66 @@ export class Project extends pulumi.CustomResource {
         return obj['__pulumiType'] === Project.__pulumiType;
     }
 
+    /**
+     * A connection URI for the default database as the default role. It embeds the role's password.
+     */
+    public /*out*/ readonly connectionUri!: pulumi.Output<string | undefined>;
+    /**
+     * When the project was created, in RFC 3339 format.
+     */
     public /*out*/ readonly createdAt!: pulumi.Output<string>;
-    public /*out*/ readonly id!: pulumi.Output<string>;
+    /**
+     * The ID of the branch Neon created along with the project.
+     */
+    public /*out*/ readonly defaultBranchId!: pulumi.Output<string | undefined>;
+    /**
+     * The database Neon created along with the project.
+     */
+    public /*out*/ readonly defaultDatabaseName!: pulumi.Output<string | undefined>;
+    /**
+     * The hostname of the read-write endpoint on the default branch.
+     */
+    public /*out*/ readonly defaultEndpointHost!: pulumi.Output<string | undefined>;
+    /**
+     * The compute settings applied to endpoints created in the project.
+     */
+    public readonly defaultEndpointSettings!: pulumi.Output<outputs.DefaultEndpointSettings | undefined>;
+    /**
+     * The role Neon created along with the project. It owns the default database.
+     */
+    public /*out*/ readonly defaultRoleName!: pulumi.Output<string | undefined>;
+    /**
+     * How long, in seconds, Neon keeps the history that branches can be created from.
+     */
+    public readonly historyRetentionSeconds!: pulumi.Output<number | undefined>;
+    /**
+     * The name of the project.
+     */
     public readonly name!: pulumi.Output<string>;
+    /**
+     * The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+     */
+    public readonly orgId!: pulumi.Output<string | undefined>;
+    /**
+     * The major Postgres version. Changing it replaces the project.
+     */
+    public readonly pgVersion!: pulumi.Output<number | undefined>;
+    /**
+     * The ID Neon assigned to the project.
+     */
+    public /*out*/ readonly projectId!: pulumi.Output<string>;
+    /**
+     * The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+     */
+    public readonly provisioner!: pulumi.Output<string | undefined>;
+    /**
+     * The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+     */
     public readonly regionId!: pulumi.Output<string>;
+    /**
+     * Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+     */
+    public readonly storePasswords!: pulumi.Output<boolean | undefined>;
 
     /**
      * Create a Project resource with the given unique name, arguments, and options.
@@ -53,17 +114,41 @@ export class Project extends pulumi.CustomResource {
             if ((!args || args.regionId === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'regionId'");
             }
+            resourceInputs["defaultEndpointSettings"] = args ? args.defaultEndpointSettings : undefined;
+            resourceInputs["historyRetentionSeconds"] = args ? args.historyRetentionSeconds : undefined;
             resourceInputs["name"] = args ? args.name : undefined;
+            resourceInputs["orgId"] = args ? args.orgId : undefined;
+            resourceInputs["pgVersion"] = args ? args.pgVersion : undefined;
+            resourceInputs["provisioner"] = args ? args.provisioner : undefined;
             resourceInputs["regionId"] = args ? args.regionId : undefined;
+            resourceInputs["storePasswords"] = args ? args.storePasswords : undefined;
+            resourceInputs["connectionUri"] = undefined /*out*/;
             resourceInputs["createdAt"] = undefined /*out*/;
-            resourceInputs["id"] = undefined /*out*/;
+            resourceInputs["defaultBranchId"] = undefined /*out*/;
+            resourceInputs["defaultDatabaseName"] = undefined /*out*/;
+            resourceInputs["defaultEndpointHost"] = undefined /*out*/;
+            resourceInputs["defaultRoleName"] = undefined /*out*/;
+            resourceInputs["projectId"] = undefined /*out*/;
         } else {
+            resourceInputs["connectionUri"] = undefined /*out*/;
             resourceInputs["createdAt"] = undefined /*out*/;
-            resourceInputs["id"] = undefined /*out*/;
+            resourceInputs["defaultBranchId"] = undefined /*out*/;
+            resourceInputs["defaultDatabaseName"] = undefined /*out*/;
+            resourceInputs["defaultEndpointHost"] = undefined /*out*/;
+            resourceInputs["defaultEndpointSettings"] = undefined /*out*/;
+            resourceInputs["defaultRoleName"] = undefined /*out*/;
+            resourceInputs["historyRetentionSeconds"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["orgId"] = undefined /*out*/;
+            resourceInputs["pgVersion"] = undefined /*out*/;
+            resourceInputs["projectId"] = undefined /*out*/;
+            resourceInputs["provisioner"] = undefined /*out*/;
             resourceInputs["regionId"] = undefined /*out*/;
+            resourceInputs["storePasswords"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["connectionUri"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
         super(Project.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -72,6 +157,36 @@ export class Project extends pulumi.CustomResource {
  * The set of arguments for constructing a Project resource.
  */
 export interface ProjectArgs {
+    /**
+     * The compute settings applied to endpoints created in the project.
+     */
+    defaultEndpointSettings?: pulumi.Input<inputs.DefaultEndpointSettingsArgs>;
+    /**
+     * How long, in seconds, Neon keeps the history that branches can be created from.
+     */
+    historyRetentionSeconds?: pulumi.Input<number>;
+    /**
+     * The name of the project.
+     */
     name: pulumi.Input<string>;
+    /**
+     * The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+     */
+    orgId?: pulumi.Input<string>;
+    /**
+     * The major Postgres version. Changing it replaces the project.
+     */
+    pgVersion?: pulumi.Input<number>;
+    /**
+     * The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+     */
+    provisioner?: pulumi.Input<string>;
+    /**
+     * The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+     */
     regionId: pulumi.Input<string>;
+    /**
+     * Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+     */
+    storePasswords?: pulumi.Input<boolean>;
 }
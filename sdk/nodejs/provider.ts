@@ -1,4 +1,4 @@
-// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
@@ -19,7 +19,22 @@ export class Provider extends pulumi.ProviderResource {
         return obj['__pulumiType'] === "pulumi:providers:" + Provider.__pulumiType;
     }
 
-    public readonly apiKey!: pulumi.Output<string>;
+    /**
+     * The Neon API key. Defaults to the NEON_API_KEY environment variable.
+     */
+    public readonly apiKey!: pulumi.Output<string | undefined>;
+    /**
+     * The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+     */
+    public readonly apiUrl!: pulumi.Output<string | undefined>;
+    /**
+     * The organization that projects are created in when they do not set their own orgId.
+     */
+    public readonly orgId!: pulumi.Output<string | undefined>;
+    /**
+     * Text appended to the User-Agent header of every request.
+     */
+    public readonly userAgentSuffix!: pulumi.Output<string | undefined>;
 
     /**
      * Create a Provider resource with the given unique name, arguments, and options.
@@ -28,16 +43,21 @@ export class Provider extends pulumi.ProviderResource {
      * @param args The arguments to use to populate this resource's properties.
      * @param opts A bag of options that control this resource's behavior.
      */
-    constructor(name: string, args: ProviderArgs, opts?: pulumi.ResourceOptions) {
+    constructor(name: string, args?: ProviderArgs, opts?: pulumi.ResourceOptions) {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         {
-            if ((!args || args.apiKey === undefined) && !opts.urn) {
-                throw new Error("Missing required property 'apiKey'");
-            }
-            resourceInputs["apiKey"] = args ? args.apiKey : undefined;
+            resourceInputs["apiKey"] = args?.apiKey ? pulumi.secret(args.apiKey) : undefined;
+            resourceInputs["apiUrl"] = args ? args.apiUrl : undefined;
+            resourceInputs["maxRetries"] = pulumi.output(args ? args.maxRetries : undefined).apply(JSON.stringify);
+            resourceInputs["orgId"] = args ? args.orgId : undefined;
+            resourceInputs["requestTimeoutSeconds"] = pulumi.output(args ? args.requestTimeoutSeconds : undefined).apply(JSON.stringify);
+            resourceInputs["retryTimeoutSeconds"] = pulumi.output(args ? args.retryTimeoutSeconds : undefined).apply(JSON.stringify);
+            resourceInputs["userAgentSuffix"] = args ? args.userAgentSuffix : undefined;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["apiKey"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
         super(Provider.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -46,5 +66,32 @@ export class Provider extends pulumi.ProviderResource {
  * The set of arguments for constructing a Provider resource.
  */
 export interface ProviderArgs {
-    apiKey: pulumi.Input<string>;
+    /**
+     * The Neon API key. Defaults to the NEON_API_KEY environment variable.
+     */
+    apiKey?: pulumi.Input<string>;
+    /**
+     * The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+     */
+    apiUrl?: pulumi.Input<string>;
+    /**
+     * How many times a failed request to the Neon API is retried. Zero disables retries.
+     */
+    maxRetries?: pulumi.Input<number>;
+    /**
+     * The organization that projects are created in when they do not set their own orgId.
+     */
+    orgId?: pulumi.Input<string>;
+    /**
+     * How long a single request to the Neon API may take.
+     */
+    requestTimeoutSeconds?: pulumi.Input<number>;
+    /**
+     * How long a request keeps retrying while Neon reports it as locked, rate limited or unavailable.
+     */
+    retryTimeoutSeconds?: pulumi.Input<number>;
+    /**
+     * Text appended to the User-Agent header of every request.
+     */
+    userAgentSuffix?: pulumi.Input<string>;
 }
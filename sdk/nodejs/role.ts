@@ -1,9 +1,12 @@
-// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
+/**
+ * A Postgres role on a Neon branch. Import it with an ID of the form projectId/branchId/roleName.
+ */
 export class Role extends pulumi.CustomResource {
     /**
      * Get an existing Role resource's state with the given name, ID, and optional extra
@@ -31,10 +34,29 @@ export class Role extends pulumi.CustomResource {
         return obj['__pulumiType'] === Role.__pulumiType;
     }
 
+    /**
+     * The ID of the branch the role is on. Changing it replaces the role.
+     */
     public readonly branchId!: pulumi.Output<string>;
+    /**
+     * When the role was created, in RFC 3339 format.
+     */
     public /*out*/ readonly createdAt!: pulumi.Output<string>;
-    public /*out*/ readonly id!: pulumi.Output<string>;
+    /**
+     * The name of the role. Changing it replaces the role.
+     */
     public readonly name!: pulumi.Output<string>;
+    /**
+     * The role's current password, as generated by Neon.
+     */
+    public /*out*/ readonly password!: pulumi.Output<string | undefined>;
+    /**
+     * An arbitrary value. Changing it resets the role's password.
+     */
+    public readonly passwordVersion!: pulumi.Output<string | undefined>;
+    /**
+     * The ID of the project the role belongs to. Changing it replaces the role.
+     */
     public readonly projectId!: pulumi.Output<string>;
 
     /**
@@ -59,17 +81,21 @@ export class Role extends pulumi.CustomResource {
             }
             resourceInputs["branchId"] = args ? args.branchId : undefined;
             resourceInputs["name"] = args ? args.name : undefined;
+            resourceInputs["passwordVersion"] = args ? args.passwordVersion : undefined;
             resourceInputs["projectId"] = args ? args.projectId : undefined;
             resourceInputs["createdAt"] = undefined /*out*/;
-            resourceInputs["id"] = undefined /*out*/;
+            resourceInputs["password"] = undefined /*out*/;
         } else {
             resourceInputs["branchId"] = undefined /*out*/;
             resourceInputs["createdAt"] = undefined /*out*/;
-            resourceInputs["id"] = undefined /*out*/;
             resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["password"] = undefined /*out*/;
+            resourceInputs["passwordVersion"] = undefined /*out*/;
             resourceInputs["projectId"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["password"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
         super(Role.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -78,7 +104,20 @@ export class Role extends pulumi.CustomResource {
  * The set of arguments for constructing a Role resource.
  */
 export interface RoleArgs {
+    /**
+     * The ID of the branch the role is on. Changing it replaces the role.
+     */
     branchId: pulumi.Input<string>;
+    /**
+     * The name of the role. Changing it replaces the role.
+     */
     name: pulumi.Input<string>;
+    /**
+     * An arbitrary value. Changing it resets the role's password.
+     */
+    passwordVersion?: pulumi.Input<string>;
+    /**
+     * The ID of the project the role belongs to. Changing it replaces the role.
+     */
     projectId: pulumi.Input<string>;
 }
@@ -0,0 +1,51 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+
+/**
+ * A compute endpoint created along with its branch.
+ */
+export interface BranchEndpointArgs {
+    /**
+     * The maximum compute size, in compute units.
+     */
+    autoscalingLimitMaxCu?: pulumi.Input<number>;
+    /**
+     * The minimum compute size, in compute units.
+     */
+    autoscalingLimitMinCu?: pulumi.Input<number>;
+    /**
+     * The compute provisioner, k8s-pod or k8s-neonvm.
+     */
+    provisioner?: pulumi.Input<string>;
+    /**
+     * How long, in seconds, an idle endpoint keeps running before it is suspended.
+     */
+    suspendTimeoutSeconds?: pulumi.Input<number>;
+    /**
+     * The endpoint type, read_write or read_only.
+     */
+    type: pulumi.Input<string>;
+}
+
+/**
+ * Compute settings applied to the endpoints created in a project.
+ */
+export interface DefaultEndpointSettingsArgs {
+    /**
+     * The maximum compute size, in compute units.
+     */
+    autoscalingLimitMaxCu?: pulumi.Input<number>;
+    /**
+     * The minimum compute size, in compute units.
+     */
+    autoscalingLimitMinCu?: pulumi.Input<number>;
+    /**
+     * How long, in seconds, an idle endpoint keeps running before it is suspended.
+     */
+    suspendTimeoutSeconds?: pulumi.Input<number>;
+}
+
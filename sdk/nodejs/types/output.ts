@@ -0,0 +1,137 @@
+// *** WARNING: this file was generated by pulumi-gen-neon. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+
+/**
+ * A compute endpoint created along with its branch.
+ */
+export interface BranchEndpoint {
+    /**
+     * The maximum compute size, in compute units.
+     */
+    autoscalingLimitMaxCu?: number;
+    /**
+     * The minimum compute size, in compute units.
+     */
+    autoscalingLimitMinCu?: number;
+    /**
+     * The compute provisioner, k8s-pod or k8s-neonvm.
+     */
+    provisioner?: string;
+    /**
+     * How long, in seconds, an idle endpoint keeps running before it is suspended.
+     */
+    suspendTimeoutSeconds?: number;
+    /**
+     * The endpoint type, read_write or read_only.
+     */
+    type: string;
+}
+
+/**
+ * An endpoint created along with its branch.
+ */
+export interface CreatedEndpoint {
+    /**
+     * The ID Neon assigned to the endpoint.
+     */
+    endpointId: string;
+    /**
+     * The hostname of the endpoint.
+     */
+    host: string;
+    /**
+     * The endpoint type, read_write or read_only.
+     */
+    type: string;
+}
+
+/**
+ * Compute settings applied to the endpoints created in a project.
+ */
+export interface DefaultEndpointSettings {
+    /**
+     * The maximum compute size, in compute units.
+     */
+    autoscalingLimitMaxCu?: number;
+    /**
+     * The minimum compute size, in compute units.
+     */
+    autoscalingLimitMinCu?: number;
+    /**
+     * How long, in seconds, an idle endpoint keeps running before it is suspended.
+     */
+    suspendTimeoutSeconds?: number;
+}
+
+/**
+ * A compute endpoint found by getEndpoints.
+ */
+export interface EndpointSummary {
+    /**
+     * The maximum compute size, in compute units.
+     */
+    autoscalingLimitMaxCu: number;
+    /**
+     * The minimum compute size, in compute units.
+     */
+    autoscalingLimitMinCu: number;
+    /**
+     * When the endpoint was created, in RFC 3339 format.
+     */
+    createdAt: string;
+    /**
+     * The state of the endpoint's compute, such as active or idle.
+     */
+    currentState?: string;
+    /**
+     * The ID of the endpoint.
+     */
+    endpointId: string;
+    /**
+     * The hostname of the endpoint.
+     */
+    host: string;
+    /**
+     * The hostname that routes connections through PgBouncer.
+     */
+    poolerHost?: string;
+    /**
+     * The port the endpoint accepts connections on.
+     */
+    port: number;
+    /**
+     * The region of the endpoint.
+     */
+    regionId?: string;
+    /**
+     * How long, in seconds, an idle endpoint keeps running before it is suspended.
+     */
+    suspendTimeoutSeconds: number;
+    /**
+     * The endpoint type, read_write or read_only.
+     */
+    type: string;
+}
+
+/**
+ * A region Neon can create projects in.
+ */
+export interface Region {
+    /**
+     * Whether projects are created in this region when they do not set one.
+     */
+    default: boolean;
+    /**
+     * The human readable name of the region.
+     */
+    name: string;
+    /**
+     * The ID of the region, such as aws-us-east-2.
+     */
+    regionId: string;
+}
+
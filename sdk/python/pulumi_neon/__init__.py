@@ -0,0 +1,57 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+from . import _utilities
+import typing
+# Export this package's modules as members:
+from .branch import *
+from .database import *
+from .endpoint import *
+from .get_branch import *
+from .get_endpoints import *
+from .get_project import *
+from .get_regions import *
+from .preview_database import *
+from .project import *
+from .provider import *
+from .role import *
+from ._inputs import *
+from . import outputs
+
+# Make subpackages available:
+if typing.TYPE_CHECKING:
+    import pulumi_neon.config as __config
+    config = __config
+else:
+    config = _utilities.lazy_import('pulumi_neon.config')
+
+_utilities.register(
+    resource_modules="""
+[
+ {
+  "pkg": "neon",
+  "mod": "index",
+  "fqn": "pulumi_neon",
+  "classes": {
+   "neon:index:Branch": "Branch",
+   "neon:index:Database": "Database",
+   "neon:index:Endpoint": "Endpoint",
+   "neon:index:PreviewDatabase": "PreviewDatabase",
+   "neon:index:Project": "Project",
+   "neon:index:Role": "Role"
+  }
+ }
+]
+""",
+    resource_packages="""
+[
+ {
+  "pkg": "neon",
+  "token": "pulumi:providers:neon",
+  "fqn": "pulumi_neon",
+  "class": "Provider"
+ }
+]
+"""
+)
@@ -0,0 +1,159 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = [
+    'BranchEndpointArgs',
+    'DefaultEndpointSettingsArgs',
+]
+
+@pulumi.input_type
+class BranchEndpointArgs:
+    def __init__(__self__, *,
+                 type: pulumi.Input[str],
+                 autoscaling_limit_max_cu: Optional[pulumi.Input[float]] = None,
+                 autoscaling_limit_min_cu: Optional[pulumi.Input[float]] = None,
+                 provisioner: Optional[pulumi.Input[str]] = None,
+                 suspend_timeout_seconds: Optional[pulumi.Input[int]] = None):
+        """
+        A compute endpoint created along with its branch.
+        :param pulumi.Input[str] type: The endpoint type, read_write or read_only.
+        :param pulumi.Input[float] autoscaling_limit_max_cu: The maximum compute size, in compute units.
+        :param pulumi.Input[float] autoscaling_limit_min_cu: The minimum compute size, in compute units.
+        :param pulumi.Input[str] provisioner: The compute provisioner, k8s-pod or k8s-neonvm.
+        :param pulumi.Input[int] suspend_timeout_seconds: How long, in seconds, an idle endpoint keeps running before it is suspended.
+        """
+        pulumi.set(__self__, "type", type)
+        if autoscaling_limit_max_cu is not None:
+            pulumi.set(__self__, "autoscaling_limit_max_cu", autoscaling_limit_max_cu)
+        if autoscaling_limit_min_cu is not None:
+            pulumi.set(__self__, "autoscaling_limit_min_cu", autoscaling_limit_min_cu)
+        if provisioner is not None:
+            pulumi.set(__self__, "provisioner", provisioner)
+        if suspend_timeout_seconds is not None:
+            pulumi.set(__self__, "suspend_timeout_seconds", suspend_timeout_seconds)
+
+    @property
+    @pulumi.getter
+    def type(self) -> pulumi.Input[str]:
+        """
+        The endpoint type, read_write or read_only.
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: pulumi.Input[str]):
+        pulumi.set(self, "type", value)
+
+    @property
+    @pulumi.getter(name="autoscalingLimitMaxCu")
+    def autoscaling_limit_max_cu(self) -> Optional[pulumi.Input[float]]:
+        """
+        The maximum compute size, in compute units.
+        """
+        return pulumi.get(self, "autoscaling_limit_max_cu")
+
+    @autoscaling_limit_max_cu.setter
+    def autoscaling_limit_max_cu(self, value: Optional[pulumi.Input[float]]):
+        pulumi.set(self, "autoscaling_limit_max_cu", value)
+
+    @property
+    @pulumi.getter(name="autoscalingLimitMinCu")
+    def autoscaling_limit_min_cu(self) -> Optional[pulumi.Input[float]]:
+        """
+        The minimum compute size, in compute units.
+        """
+        return pulumi.get(self, "autoscaling_limit_min_cu")
+
+    @autoscaling_limit_min_cu.setter
+    def autoscaling_limit_min_cu(self, value: Optional[pulumi.Input[float]]):
+        pulumi.set(self, "autoscaling_limit_min_cu", value)
+
+    @property
+    @pulumi.getter
+    def provisioner(self) -> Optional[pulumi.Input[str]]:
+        """
+        The compute provisioner, k8s-pod or k8s-neonvm.
+        """
+        return pulumi.get(self, "provisioner")
+
+    @provisioner.setter
+    def provisioner(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "provisioner", value)
+
+    @property
+    @pulumi.getter(name="suspendTimeoutSeconds")
+    def suspend_timeout_seconds(self) -> Optional[pulumi.Input[int]]:
+        """
+        How long, in seconds, an idle endpoint keeps running before it is suspended.
+        """
+        return pulumi.get(self, "suspend_timeout_seconds")
+
+    @suspend_timeout_seconds.setter
+    def suspend_timeout_seconds(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "suspend_timeout_seconds", value)
+
+
+@pulumi.input_type
+class DefaultEndpointSettingsArgs:
+    def __init__(__self__, *,
+                 autoscaling_limit_max_cu: Optional[pulumi.Input[float]] = None,
+                 autoscaling_limit_min_cu: Optional[pulumi.Input[float]] = None,
+                 suspend_timeout_seconds: Optional[pulumi.Input[int]] = None):
+        """
+        Compute settings applied to the endpoints created in a project.
+        :param pulumi.Input[float] autoscaling_limit_max_cu: The maximum compute size, in compute units.
+        :param pulumi.Input[float] autoscaling_limit_min_cu: The minimum compute size, in compute units.
+        :param pulumi.Input[int] suspend_timeout_seconds: How long, in seconds, an idle endpoint keeps running before it is suspended.
+        """
+        if autoscaling_limit_max_cu is not None:
+            pulumi.set(__self__, "autoscaling_limit_max_cu", autoscaling_limit_max_cu)
+        if autoscaling_limit_min_cu is not None:
+            pulumi.set(__self__, "autoscaling_limit_min_cu", autoscaling_limit_min_cu)
+        if suspend_timeout_seconds is not None:
+            pulumi.set(__self__, "suspend_timeout_seconds", suspend_timeout_seconds)
+
+    @property
+    @pulumi.getter(name="autoscalingLimitMaxCu")
+    def autoscaling_limit_max_cu(self) -> Optional[pulumi.Input[float]]:
+        """
+        The maximum compute size, in compute units.
+        """
+        return pulumi.get(self, "autoscaling_limit_max_cu")
+
+    @autoscaling_limit_max_cu.setter
+    def autoscaling_limit_max_cu(self, value: Optional[pulumi.Input[float]]):
+        pulumi.set(self, "autoscaling_limit_max_cu", value)
+
+    @property
+    @pulumi.getter(name="autoscalingLimitMinCu")
+    def autoscaling_limit_min_cu(self) -> Optional[pulumi.Input[float]]:
+        """
+        The minimum compute size, in compute units.
+        """
+        return pulumi.get(self, "autoscaling_limit_min_cu")
+
+    @autoscaling_limit_min_cu.setter
+    def autoscaling_limit_min_cu(self, value: Optional[pulumi.Input[float]]):
+        pulumi.set(self, "autoscaling_limit_min_cu", value)
+
+    @property
+    @pulumi.getter(name="suspendTimeoutSeconds")
+    def suspend_timeout_seconds(self) -> Optional[pulumi.Input[int]]:
+        """
+        How long, in seconds, an idle endpoint keeps running before it is suspended.
+        """
+        return pulumi.get(self, "suspend_timeout_seconds")
+
+    @suspend_timeout_seconds.setter
+    def suspend_timeout_seconds(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "suspend_timeout_seconds", value)
+
+
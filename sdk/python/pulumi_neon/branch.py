@@ -0,0 +1,510 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['BranchArgs', 'Branch']
+
+@pulumi.input_type
+class BranchArgs:
+    def __init__(__self__, *,
+                 name: pulumi.Input[str],
+                 project_id: pulumi.Input[str],
+                 endpoints: Optional[pulumi.Input[Sequence[pulumi.Input['BranchEndpointArgs']]]] = None,
+                 expires_at: Optional[pulumi.Input[str]] = None,
+                 parent_id: Optional[pulumi.Input[str]] = None,
+                 parent_lsn: Optional[pulumi.Input[str]] = None,
+                 parent_timestamp: Optional[pulumi.Input[str]] = None,
+                 ttl: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a Branch resource.
+        :param pulumi.Input[str] name: The name of the branch.
+        :param pulumi.Input[str] project_id: The ID of the project the branch belongs to. Changing it replaces the branch.
+        :param pulumi.Input[Sequence[pulumi.Input['BranchEndpointArgs']]] endpoints: Compute endpoints to create together with the branch. Changing them replaces the branch.
+        :param pulumi.Input[str] expires_at: The RFC 3339 time at which Neon deletes the branch. Only one of expiresAt and ttl may be set.
+        :param pulumi.Input[str] parent_id: The ID of the branch to branch from. Defaults to the project's default branch. Changing it replaces the branch.
+        :param pulumi.Input[str] parent_lsn: Branch from the parent as of this Log Sequence Number. Changing it replaces the branch.
+        :param pulumi.Input[str] parent_timestamp: Branch from the parent as of this RFC 3339 point in time. Changing it replaces the branch.
+        :param pulumi.Input[str] ttl: How long after its creation Neon deletes the branch, as a duration such as 72h. Only one of expiresAt and ttl may be set.
+        """
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "project_id", project_id)
+        if endpoints is not None:
+            pulumi.set(__self__, "endpoints", endpoints)
+        if expires_at is not None:
+            pulumi.set(__self__, "expires_at", expires_at)
+        if parent_id is not None:
+            pulumi.set(__self__, "parent_id", parent_id)
+        if parent_lsn is not None:
+            pulumi.set(__self__, "parent_lsn", parent_lsn)
+        if parent_timestamp is not None:
+            pulumi.set(__self__, "parent_timestamp", parent_timestamp)
+        if ttl is not None:
+            pulumi.set(__self__, "ttl", ttl)
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[str]:
+        """
+        The name of the branch.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[str]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Input[str]:
+        """
+        The ID of the project the branch belongs to. Changing it replaces the branch.
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "project_id", value)
+
+    @property
+    @pulumi.getter
+    def endpoints(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['BranchEndpointArgs']]]]:
+        """
+        Compute endpoints to create together with the branch. Changing them replaces the branch.
+        """
+        return pulumi.get(self, "endpoints")
+
+    @endpoints.setter
+    def endpoints(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['BranchEndpointArgs']]]]):
+        pulumi.set(self, "endpoints", value)
+
+    @property
+    @pulumi.getter(name="expiresAt")
+    def expires_at(self) -> Optional[pulumi.Input[str]]:
+        """
+        The RFC 3339 time at which Neon deletes the branch. Only one of expiresAt and ttl may be set.
+        """
+        return pulumi.get(self, "expires_at")
+
+    @expires_at.setter
+    def expires_at(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "expires_at", value)
+
+    @property
+    @pulumi.getter(name="parentId")
+    def parent_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The ID of the branch to branch from. Defaults to the project's default branch. Changing it replaces the branch.
+        """
+        return pulumi.get(self, "parent_id")
+
+    @parent_id.setter
+    def parent_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "parent_id", value)
+
+    @property
+    @pulumi.getter(name="parentLsn")
+    def parent_lsn(self) -> Optional[pulumi.Input[str]]:
+        """
+        Branch from the parent as of this Log Sequence Number. Changing it replaces the branch.
+        """
+        return pulumi.get(self, "parent_lsn")
+
+    @parent_lsn.setter
+    def parent_lsn(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "parent_lsn", value)
+
+    @property
+    @pulumi.getter(name="parentTimestamp")
+    def parent_timestamp(self) -> Optional[pulumi.Input[str]]:
+        """
+        Branch from the parent as of this RFC 3339 point in time. Changing it replaces the branch.
+        """
+        return pulumi.get(self, "parent_timestamp")
+
+    @parent_timestamp.setter
+    def parent_timestamp(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "parent_timestamp", value)
+
+    @property
+    @pulumi.getter
+    def ttl(self) -> Optional[pulumi.Input[str]]:
+        """
+        How long after its creation Neon deletes the branch, as a duration such as 72h. Only one of expiresAt and ttl may be set.
+        """
+        return pulumi.get(self, "ttl")
+
+    @ttl.setter
+    def ttl(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "ttl", value)
+
+
+class Branch(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 endpoints: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['BranchEndpointArgs']]]]] = None,
+                 expires_at: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 parent_id: Optional[pulumi.Input[str]] = None,
+                 parent_lsn: Optional[pulumi.Input[str]] = None,
+                 parent_timestamp: Optional[pulumi.Input[str]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 ttl: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        A branch of a Neon project. Import it with an ID of the form projectId/branchId.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['BranchEndpointArgs']]]] endpoints: Compute endpoints to create together with the branch. Changing them replaces the branch.
+        :param pulumi.Input[str] expires_at: The RFC 3339 time at which Neon deletes the branch. Only one of expiresAt and ttl may be set.
+        :param pulumi.Input[str] name: The name of the branch.
+        :param pulumi.Input[str] parent_id: The ID of the branch to branch from. Defaults to the project's default branch. Changing it replaces the branch.
+        :param pulumi.Input[str] parent_lsn: Branch from the parent as of this Log Sequence Number. Changing it replaces the branch.
+        :param pulumi.Input[str] parent_timestamp: Branch from the parent as of this RFC 3339 point in time. Changing it replaces the branch.
+        :param pulumi.Input[str] project_id: The ID of the project the branch belongs to. Changing it replaces the branch.
+        :param pulumi.Input[str] ttl: How long after its creation Neon deletes the branch, as a duration such as 72h. Only one of expiresAt and ttl may be set.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: BranchArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A branch of a Neon project. Import it with an ID of the form projectId/branchId.
+
+        :param str resource_name: The name of the resource.
+        :param BranchArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(BranchArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 endpoints: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['BranchEndpointArgs']]]]] = None,
+                 expires_at: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 parent_id: Optional[pulumi.Input[str]] = None,
+                 parent_lsn: Optional[pulumi.Input[str]] = None,
+                 parent_timestamp: Optional[pulumi.Input[str]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 ttl: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = BranchArgs.__new__(BranchArgs)
+
+            __props__.__dict__["endpoints"] = endpoints
+            __props__.__dict__["expires_at"] = expires_at
+            if name is None and not opts.urn:
+                raise TypeError("Missing required property 'name'")
+            __props__.__dict__["name"] = name
+            __props__.__dict__["parent_id"] = parent_id
+            __props__.__dict__["parent_lsn"] = parent_lsn
+            __props__.__dict__["parent_timestamp"] = parent_timestamp
+            if project_id is None and not opts.urn:
+                raise TypeError("Missing required property 'project_id'")
+            __props__.__dict__["project_id"] = project_id
+            __props__.__dict__["ttl"] = ttl
+            __props__.__dict__["branch_id"] = None
+            __props__.__dict__["created_at"] = None
+            __props__.__dict__["created_endpoints"] = None
+        super(Branch, __self__).__init__(
+            'neon:index:Branch',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Branch':
+        """
+        Get an existing Branch resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = BranchArgs.__new__(BranchArgs)
+
+        __props__.__dict__["branch_id"] = None
+        __props__.__dict__["created_at"] = None
+        __props__.__dict__["created_endpoints"] = None
+        __props__.__dict__["endpoints"] = None
+        __props__.__dict__["expires_at"] = None
+        __props__.__dict__["name"] = None
+        __props__.__dict__["parent_id"] = None
+        __props__.__dict__["parent_lsn"] = None
+        __props__.__dict__["parent_timestamp"] = None
+        __props__.__dict__["project_id"] = None
+        __props__.__dict__["ttl"] = None
+        return Branch(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="branchId")
+    def branch_id(self) -> pulumi.Output[str]:
+        """
+        The ID Neon assigned to the branch.
+        """
+        return pulumi.get(self, "branch_id")
+
+    @property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> pulumi.Output[str]:
+        """
+        When the branch was created, in RFC 3339 format.
+        """
+        return pulumi.get(self, "created_at")
+
+    @property
+    @pulumi.getter(name="createdEndpoints")
+    def created_endpoints(self) -> pulumi.Output[Optional[Sequence['outputs.CreatedEndpoint']]]:
+        """
+        The endpoints created from endpoints, in the same order. They are deleted along with the branch.
+        """
+        return pulumi.get(self, "created_endpoints")
+
+    @property
+    @pulumi.getter
+    def endpoints(self) -> pulumi.Output[Optional[Sequence['outputs.BranchEndpoint']]]:
+        """
+        Compute endpoints to create together with the branch. Changing them replaces the branch.
+        """
+        return pulumi.get(self, "endpoints")
+
+    @property
+    @pulumi.getter(name="expiresAt")
+    def expires_at(self) -> pulumi.Output[Optional[str]]:
+        """
+        The RFC 3339 time at which Neon deletes the branch. Only one of expiresAt and ttl may be set.
+        """
+        return pulumi.get(self, "expires_at")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        """
+        The name of the branch.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="parentId")
+    def parent_id(self) -> pulumi.Output[Optional[str]]:
+        """
+        The ID of the branch to branch from. Defaults to the project's default branch. Changing it replaces the branch.
+        """
+        return pulumi.get(self, "parent_id")
+
+    @property
+    @pulumi.getter(name="parentLsn")
+    def parent_lsn(self) -> pulumi.Output[Optional[str]]:
+        """
+        Branch from the parent as of this Log Sequence Number. Changing it replaces the branch.
+        """
+        return pulumi.get(self, "parent_lsn")
+
+    @property
+    @pulumi.getter(name="parentTimestamp")
+    def parent_timestamp(self) -> pulumi.Output[Optional[str]]:
+        """
+        Branch from the parent as of this RFC 3339 point in time. Changing it replaces the branch.
+        """
+        return pulumi.get(self, "parent_timestamp")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the project the branch belongs to. Changing it replaces the branch.
+        """
+        return pulumi.get(self, "project_id")
+
+    @property
+    @pulumi.getter
+    def ttl(self) -> pulumi.Output[Optional[str]]:
+        """
+        How long after its creation Neon deletes the branch, as a duration such as 72h. Only one of expiresAt and ttl may be set.
+        """
+        return pulumi.get(self, "ttl")
+
+    @pulumi.output_type
+    class ResetToParentResult:
+        """
+        The branch after a method has changed it.
+        """
+        def __init__(__self__, branch_id=None, name=None, parent_id=None, parent_lsn=None, parent_timestamp=None):
+            if branch_id and not isinstance(branch_id, str):
+                raise TypeError("Expected argument 'branch_id' to be a str")
+            pulumi.set(__self__, "branch_id", branch_id)
+            if name and not isinstance(name, str):
+                raise TypeError("Expected argument 'name' to be a str")
+            pulumi.set(__self__, "name", name)
+            if parent_id and not isinstance(parent_id, str):
+                raise TypeError("Expected argument 'parent_id' to be a str")
+            pulumi.set(__self__, "parent_id", parent_id)
+            if parent_lsn and not isinstance(parent_lsn, str):
+                raise TypeError("Expected argument 'parent_lsn' to be a str")
+            pulumi.set(__self__, "parent_lsn", parent_lsn)
+            if parent_timestamp and not isinstance(parent_timestamp, str):
+                raise TypeError("Expected argument 'parent_timestamp' to be a str")
+            pulumi.set(__self__, "parent_timestamp", parent_timestamp)
+
+        @property
+        @pulumi.getter(name="branchId")
+        def branch_id(self) -> str:
+            """
+            The ID of the branch.
+            """
+            return pulumi.get(self, "branch_id")
+
+        @property
+        @pulumi.getter
+        def name(self) -> str:
+            """
+            The name of the branch.
+            """
+            return pulumi.get(self, "name")
+
+        @property
+        @pulumi.getter(name="parentId")
+        def parent_id(self) -> Optional[str]:
+            """
+            The ID of the branch's parent.
+            """
+            return pulumi.get(self, "parent_id")
+
+        @property
+        @pulumi.getter(name="parentLsn")
+        def parent_lsn(self) -> Optional[str]:
+            """
+            The Log Sequence Number of the parent the branch now starts from.
+            """
+            return pulumi.get(self, "parent_lsn")
+
+        @property
+        @pulumi.getter(name="parentTimestamp")
+        def parent_timestamp(self) -> Optional[str]:
+            """
+            The point in time of the parent the branch now starts from.
+            """
+            return pulumi.get(self, "parent_timestamp")
+
+    def reset_to_parent(__self__) -> pulumi.Output['Branch.ResetToParentResult']:
+        """
+        Reset the branch to the latest state of its parent, discarding its own changes.
+        """
+        __args__ = dict()
+        __args__['__self__'] = __self__
+        return pulumi.runtime.call('neon:index:Branch/resetToParent', __args__, res=__self__, typ=Branch.ResetToParentResult)
+
+    @pulumi.output_type
+    class RestoreResult:
+        """
+        The branch after a method has changed it.
+        """
+        def __init__(__self__, branch_id=None, name=None, parent_id=None, parent_lsn=None, parent_timestamp=None):
+            if branch_id and not isinstance(branch_id, str):
+                raise TypeError("Expected argument 'branch_id' to be a str")
+            pulumi.set(__self__, "branch_id", branch_id)
+            if name and not isinstance(name, str):
+                raise TypeError("Expected argument 'name' to be a str")
+            pulumi.set(__self__, "name", name)
+            if parent_id and not isinstance(parent_id, str):
+                raise TypeError("Expected argument 'parent_id' to be a str")
+            pulumi.set(__self__, "parent_id", parent_id)
+            if parent_lsn and not isinstance(parent_lsn, str):
+                raise TypeError("Expected argument 'parent_lsn' to be a str")
+            pulumi.set(__self__, "parent_lsn", parent_lsn)
+            if parent_timestamp and not isinstance(parent_timestamp, str):
+                raise TypeError("Expected argument 'parent_timestamp' to be a str")
+            pulumi.set(__self__, "parent_timestamp", parent_timestamp)
+
+        @property
+        @pulumi.getter(name="branchId")
+        def branch_id(self) -> str:
+            """
+            The ID of the branch.
+            """
+            return pulumi.get(self, "branch_id")
+
+        @property
+        @pulumi.getter
+        def name(self) -> str:
+            """
+            The name of the branch.
+            """
+            return pulumi.get(self, "name")
+
+        @property
+        @pulumi.getter(name="parentId")
+        def parent_id(self) -> Optional[str]:
+            """
+            The ID of the branch's parent.
+            """
+            return pulumi.get(self, "parent_id")
+
+        @property
+        @pulumi.getter(name="parentLsn")
+        def parent_lsn(self) -> Optional[str]:
+            """
+            The Log Sequence Number of the parent the branch now starts from.
+            """
+            return pulumi.get(self, "parent_lsn")
+
+        @property
+        @pulumi.getter(name="parentTimestamp")
+        def parent_timestamp(self) -> Optional[str]:
+            """
+            The point in time of the parent the branch now starts from.
+            """
+            return pulumi.get(self, "parent_timestamp")
+
+    def restore(__self__, *,
+                source_branch_id: pulumi.Input[str],
+                lsn: Optional[pulumi.Input[str]] = None,
+                preserve_under_name: Optional[pulumi.Input[str]] = None,
+                timestamp: Optional[pulumi.Input[str]] = None) -> pulumi.Output['Branch.RestoreResult']:
+        """
+        Restore the branch's data from a source branch, as of its head, an LSN or a point in time.
+
+
+        :param pulumi.Input[str] source_branch_id: The ID of the branch to restore from.
+        :param pulumi.Input[str] lsn: Restore the source as of this Log Sequence Number. Only one of lsn and timestamp may be set.
+        :param pulumi.Input[str] preserve_under_name: Save the branch's data from before the restore as a new branch with this name.
+        :param pulumi.Input[str] timestamp: Restore the source as of this RFC 3339 point in time. Only one of lsn and timestamp may be set.
+        """
+        __args__ = dict()
+        __args__['__self__'] = __self__
+        __args__['sourceBranchId'] = source_branch_id
+        __args__['lsn'] = lsn
+        __args__['preserveUnderName'] = preserve_under_name
+        __args__['timestamp'] = timestamp
+        return pulumi.runtime.call('neon:index:Branch/restore', __args__, res=__self__, typ=Branch.RestoreResult)
+
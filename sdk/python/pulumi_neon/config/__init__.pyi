@@ -0,0 +1,46 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+apiKey: Optional[str]
+"""
+The Neon API key. Defaults to the NEON_API_KEY environment variable.
+"""
+
+apiUrl: Optional[str]
+"""
+The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+"""
+
+maxRetries: Optional[int]
+"""
+How many times a failed request to the Neon API is retried. Zero disables retries.
+"""
+
+orgId: Optional[str]
+"""
+The organization that projects are created in when they do not set their own orgId.
+"""
+
+requestTimeoutSeconds: Optional[int]
+"""
+How long a single request to the Neon API may take.
+"""
+
+retryTimeoutSeconds: Optional[int]
+"""
+How long a request keeps retrying while Neon reports it as locked, rate limited or unavailable.
+"""
+
+userAgentSuffix: Optional[str]
+"""
+Text appended to the User-Agent header of every request.
+"""
+
@@ -0,0 +1,66 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+import types
+
+__config__ = pulumi.Config('neon')
+
+
+class _ExportableConfig(types.ModuleType):
+    @property
+    def api_key(self) -> Optional[str]:
+        """
+        The Neon API key. Defaults to the NEON_API_KEY environment variable.
+        """
+        return __config__.get('apiKey')
+
+    @property
+    def api_url(self) -> Optional[str]:
+        """
+        The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+        """
+        return __config__.get('apiUrl')
+
+    @property
+    def max_retries(self) -> Optional[int]:
+        """
+        How many times a failed request to the Neon API is retried. Zero disables retries.
+        """
+        return __config__.get_int('maxRetries')
+
+    @property
+    def org_id(self) -> Optional[str]:
+        """
+        The organization that projects are created in when they do not set their own orgId.
+        """
+        return __config__.get('orgId')
+
+    @property
+    def request_timeout_seconds(self) -> Optional[int]:
+        """
+        How long a single request to the Neon API may take.
+        """
+        return __config__.get_int('requestTimeoutSeconds')
+
+    @property
+    def retry_timeout_seconds(self) -> Optional[int]:
+        """
+        How long a request keeps retrying while Neon reports it as locked, rate limited or unavailable.
+        """
+        return __config__.get_int('retryTimeoutSeconds')
+
+    @property
+    def user_agent_suffix(self) -> Optional[str]:
+        """
+        Text appended to the User-Agent header of every request.
+        """
+        return __config__.get('userAgentSuffix')
+
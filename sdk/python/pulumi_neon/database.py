@@ -0,0 +1,230 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['DatabaseArgs', 'Database']
+
+@pulumi.input_type
+class DatabaseArgs:
+    def __init__(__self__, *,
+                 branch_id: pulumi.Input[str],
+                 name: pulumi.Input[str],
+                 owner_name: pulumi.Input[str],
+                 project_id: pulumi.Input[str]):
+        """
+        The set of arguments for constructing a Database resource.
+        :param pulumi.Input[str] branch_id: The ID of the branch the database is on. Changing it replaces the database.
+        :param pulumi.Input[str] name: The name of the database.
+        :param pulumi.Input[str] owner_name: The role that owns the database, typically the name of a Role resource.
+        :param pulumi.Input[str] project_id: The ID of the project the database belongs to. Changing it replaces the database.
+        """
+        pulumi.set(__self__, "branch_id", branch_id)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "owner_name", owner_name)
+        pulumi.set(__self__, "project_id", project_id)
+
+    @property
+    @pulumi.getter(name="branchId")
+    def branch_id(self) -> pulumi.Input[str]:
+        """
+        The ID of the branch the database is on. Changing it replaces the database.
+        """
+        return pulumi.get(self, "branch_id")
+
+    @branch_id.setter
+    def branch_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "branch_id", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[str]:
+        """
+        The name of the database.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[str]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter(name="ownerName")
+    def owner_name(self) -> pulumi.Input[str]:
+        """
+        The role that owns the database, typically the name of a Role resource.
+        """
+        return pulumi.get(self, "owner_name")
+
+    @owner_name.setter
+    def owner_name(self, value: pulumi.Input[str]):
+        pulumi.set(self, "owner_name", value)
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Input[str]:
+        """
+        The ID of the project the database belongs to. Changing it replaces the database.
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "project_id", value)
+
+
+class Database(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 branch_id: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 owner_name: Optional[pulumi.Input[str]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        A Postgres database on a Neon branch. Import it with an ID of the form projectId/branchId/databaseId, where the database's name may be given in place of its ID.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] branch_id: The ID of the branch the database is on. Changing it replaces the database.
+        :param pulumi.Input[str] name: The name of the database.
+        :param pulumi.Input[str] owner_name: The role that owns the database, typically the name of a Role resource.
+        :param pulumi.Input[str] project_id: The ID of the project the database belongs to. Changing it replaces the database.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: DatabaseArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A Postgres database on a Neon branch. Import it with an ID of the form projectId/branchId/databaseId, where the database's name may be given in place of its ID.
+
+        :param str resource_name: The name of the resource.
+        :param DatabaseArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(DatabaseArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 branch_id: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 owner_name: Optional[pulumi.Input[str]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = DatabaseArgs.__new__(DatabaseArgs)
+
+            if branch_id is None and not opts.urn:
+                raise TypeError("Missing required property 'branch_id'")
+            __props__.__dict__["branch_id"] = branch_id
+            if name is None and not opts.urn:
+                raise TypeError("Missing required property 'name'")
+            __props__.__dict__["name"] = name
+            if owner_name is None and not opts.urn:
+                raise TypeError("Missing required property 'owner_name'")
+            __props__.__dict__["owner_name"] = owner_name
+            if project_id is None and not opts.urn:
+                raise TypeError("Missing required property 'project_id'")
+            __props__.__dict__["project_id"] = project_id
+            __props__.__dict__["created_at"] = None
+            __props__.__dict__["database_id"] = None
+        super(Database, __self__).__init__(
+            'neon:index:Database',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Database':
+        """
+        Get an existing Database resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = DatabaseArgs.__new__(DatabaseArgs)
+
+        __props__.__dict__["branch_id"] = None
+        __props__.__dict__["created_at"] = None
+        __props__.__dict__["database_id"] = None
+        __props__.__dict__["name"] = None
+        __props__.__dict__["owner_name"] = None
+        __props__.__dict__["project_id"] = None
+        return Database(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="branchId")
+    def branch_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the branch the database is on. Changing it replaces the database.
+        """
+        return pulumi.get(self, "branch_id")
+
+    @property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> pulumi.Output[str]:
+        """
+        When the database was created, in RFC 3339 format.
+        """
+        return pulumi.get(self, "created_at")
+
+    @property
+    @pulumi.getter(name="databaseId")
+    def database_id(self) -> pulumi.Output[str]:
+        """
+        The ID Neon assigned to the database.
+        """
+        return pulumi.get(self, "database_id")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        """
+        The name of the database.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="ownerName")
+    def owner_name(self) -> pulumi.Output[str]:
+        """
+        The role that owns the database, typically the name of a Role resource.
+        """
+        return pulumi.get(self, "owner_name")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the project the database belongs to. Changing it replaces the database.
+        """
+        return pulumi.get(self, "project_id")
+
@@ -0,0 +1,572 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['EndpointArgs', 'Endpoint']
+
+@pulumi.input_type
+class EndpointArgs:
+    def __init__(__self__, *,
+                 branch_id: pulumi.Input[str],
+                 project_id: pulumi.Input[str],
+                 type: pulumi.Input[str],
+                 autoscaling_limit_max_cu: Optional[pulumi.Input[float]] = None,
+                 autoscaling_limit_min_cu: Optional[pulumi.Input[float]] = None,
+                 database_name: Optional[pulumi.Input[str]] = None,
+                 pooler_enabled: Optional[pulumi.Input[bool]] = None,
+                 pooler_mode: Optional[pulumi.Input[str]] = None,
+                 provisioner: Optional[pulumi.Input[str]] = None,
+                 region_id: Optional[pulumi.Input[str]] = None,
+                 role_name: Optional[pulumi.Input[str]] = None,
+                 settings: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 suspend_timeout_seconds: Optional[pulumi.Input[int]] = None):
+        """
+        The set of arguments for constructing a Endpoint resource.
+        :param pulumi.Input[str] branch_id: The ID of the branch the endpoint serves. Changing it moves the endpoint to that branch.
+        :param pulumi.Input[str] project_id: The ID of the project the endpoint belongs to. Changing it replaces the endpoint.
+        :param pulumi.Input[str] type: The endpoint type, read_write or read_only. Changing it replaces the endpoint.
+        :param pulumi.Input[float] autoscaling_limit_max_cu: The maximum compute size, in compute units.
+        :param pulumi.Input[float] autoscaling_limit_min_cu: The minimum compute size, in compute units.
+        :param pulumi.Input[str] database_name: The database the connection URIs connect to. The URIs are only filled in when roleName is set too.
+        :param pulumi.Input[bool] pooler_enabled: Whether connections can go through the PgBouncer connection pooler.
+        :param pulumi.Input[str] pooler_mode: The connection pooler mode, such as transaction.
+        :param pulumi.Input[str] provisioner: The compute provisioner, k8s-pod or k8s-neonvm.
+        :param pulumi.Input[str] region_id: The region of the endpoint, which must match the project's region. Changing it replaces the endpoint.
+        :param pulumi.Input[str] role_name: The role whose credentials the connection URIs use. The URIs are only filled in when databaseName is set too.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] settings: Postgres settings applied to the endpoint's compute.
+        :param pulumi.Input[int] suspend_timeout_seconds: How long, in seconds, an idle endpoint keeps running before it is suspended.
+        """
+        pulumi.set(__self__, "branch_id", branch_id)
+        pulumi.set(__self__, "project_id", project_id)
+        pulumi.set(__self__, "type", type)
+        if autoscaling_limit_max_cu is not None:
+            pulumi.set(__self__, "autoscaling_limit_max_cu", autoscaling_limit_max_cu)
+        if autoscaling_limit_min_cu is not None:
+            pulumi.set(__self__, "autoscaling_limit_min_cu", autoscaling_limit_min_cu)
+        if database_name is not None:
+            pulumi.set(__self__, "database_name", database_name)
+        if pooler_enabled is not None:
+            pulumi.set(__self__, "pooler_enabled", pooler_enabled)
+        if pooler_mode is not None:
+            pulumi.set(__self__, "pooler_mode", pooler_mode)
+        if provisioner is not None:
+            pulumi.set(__self__, "provisioner", provisioner)
+        if region_id is not None:
+            pulumi.set(__self__, "region_id", region_id)
+        if role_name is not None:
+            pulumi.set(__self__, "role_name", role_name)
+        if settings is not None:
+            pulumi.set(__self__, "settings", settings)
+        if suspend_timeout_seconds is not None:
+            pulumi.set(__self__, "suspend_timeout_seconds", suspend_timeout_seconds)
+
+    @property
+    @pulumi.getter(name="branchId")
+    def branch_id(self) -> pulumi.Input[str]:
+        """
+        The ID of the branch the endpoint serves. Changing it moves the endpoint to that branch.
+        """
+        return pulumi.get(self, "branch_id")
+
+    @branch_id.setter
+    def branch_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "branch_id", value)
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Input[str]:
+        """
+        The ID of the project the endpoint belongs to. Changing it replaces the endpoint.
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "project_id", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> pulumi.Input[str]:
+        """
+        The endpoint type, read_write or read_only. Changing it replaces the endpoint.
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: pulumi.Input[str]):
+        pulumi.set(self, "type", value)
+
+    @property
+    @pulumi.getter(name="autoscalingLimitMaxCu")
+    def autoscaling_limit_max_cu(self) -> Optional[pulumi.Input[float]]:
+        """
+        The maximum compute size, in compute units.
+        """
+        return pulumi.get(self, "autoscaling_limit_max_cu")
+
+    @autoscaling_limit_max_cu.setter
+    def autoscaling_limit_max_cu(self, value: Optional[pulumi.Input[float]]):
+        pulumi.set(self, "autoscaling_limit_max_cu", value)
+
+    @property
+    @pulumi.getter(name="autoscalingLimitMinCu")
+    def autoscaling_limit_min_cu(self) -> Optional[pulumi.Input[float]]:
+        """
+        The minimum compute size, in compute units.
+        """
+        return pulumi.get(self, "autoscaling_limit_min_cu")
+
+    @autoscaling_limit_min_cu.setter
+    def autoscaling_limit_min_cu(self, value: Optional[pulumi.Input[float]]):
+        pulumi.set(self, "autoscaling_limit_min_cu", value)
+
+    @property
+    @pulumi.getter(name="databaseName")
+    def database_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The database the connection URIs connect to. The URIs are only filled in when roleName is set too.
+        """
+        return pulumi.get(self, "database_name")
+
+    @database_name.setter
+    def database_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "database_name", value)
+
+    @property
+    @pulumi.getter(name="poolerEnabled")
+    def pooler_enabled(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether connections can go through the PgBouncer connection pooler.
+        """
+        return pulumi.get(self, "pooler_enabled")
+
+    @pooler_enabled.setter
+    def pooler_enabled(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "pooler_enabled", value)
+
+    @property
+    @pulumi.getter(name="poolerMode")
+    def pooler_mode(self) -> Optional[pulumi.Input[str]]:
+        """
+        The connection pooler mode, such as transaction.
+        """
+        return pulumi.get(self, "pooler_mode")
+
+    @pooler_mode.setter
+    def pooler_mode(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "pooler_mode", value)
+
+    @property
+    @pulumi.getter
+    def provisioner(self) -> Optional[pulumi.Input[str]]:
+        """
+        The compute provisioner, k8s-pod or k8s-neonvm.
+        """
+        return pulumi.get(self, "provisioner")
+
+    @provisioner.setter
+    def provisioner(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "provisioner", value)
+
+    @property
+    @pulumi.getter(name="regionId")
+    def region_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The region of the endpoint, which must match the project's region. Changing it replaces the endpoint.
+        """
+        return pulumi.get(self, "region_id")
+
+    @region_id.setter
+    def region_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "region_id", value)
+
+    @property
+    @pulumi.getter(name="roleName")
+    def role_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The role whose credentials the connection URIs use. The URIs are only filled in when databaseName is set too.
+        """
+        return pulumi.get(self, "role_name")
+
+    @role_name.setter
+    def role_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "role_name", value)
+
+    @property
+    @pulumi.getter
+    def settings(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Postgres settings applied to the endpoint's compute.
+        """
+        return pulumi.get(self, "settings")
+
+    @settings.setter
+    def settings(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "settings", value)
+
+    @property
+    @pulumi.getter(name="suspendTimeoutSeconds")
+    def suspend_timeout_seconds(self) -> Optional[pulumi.Input[int]]:
+        """
+        How long, in seconds, an idle endpoint keeps running before it is suspended.
+        """
+        return pulumi.get(self, "suspend_timeout_seconds")
+
+    @suspend_timeout_seconds.setter
+    def suspend_timeout_seconds(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "suspend_timeout_seconds", value)
+
+
+class Endpoint(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 autoscaling_limit_max_cu: Optional[pulumi.Input[float]] = None,
+                 autoscaling_limit_min_cu: Optional[pulumi.Input[float]] = None,
+                 branch_id: Optional[pulumi.Input[str]] = None,
+                 database_name: Optional[pulumi.Input[str]] = None,
+                 pooler_enabled: Optional[pulumi.Input[bool]] = None,
+                 pooler_mode: Optional[pulumi.Input[str]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 provisioner: Optional[pulumi.Input[str]] = None,
+                 region_id: Optional[pulumi.Input[str]] = None,
+                 role_name: Optional[pulumi.Input[str]] = None,
+                 settings: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 suspend_timeout_seconds: Optional[pulumi.Input[int]] = None,
+                 type: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        A compute endpoint on a Neon branch. Import it with an ID of the form projectId/endpointId.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[float] autoscaling_limit_max_cu: The maximum compute size, in compute units.
+        :param pulumi.Input[float] autoscaling_limit_min_cu: The minimum compute size, in compute units.
+        :param pulumi.Input[str] branch_id: The ID of the branch the endpoint serves. Changing it moves the endpoint to that branch.
+        :param pulumi.Input[str] database_name: The database the connection URIs connect to. The URIs are only filled in when roleName is set too.
+        :param pulumi.Input[bool] pooler_enabled: Whether connections can go through the PgBouncer connection pooler.
+        :param pulumi.Input[str] pooler_mode: The connection pooler mode, such as transaction.
+        :param pulumi.Input[str] project_id: The ID of the project the endpoint belongs to. Changing it replaces the endpoint.
+        :param pulumi.Input[str] provisioner: The compute provisioner, k8s-pod or k8s-neonvm.
+        :param pulumi.Input[str] region_id: The region of the endpoint, which must match the project's region. Changing it replaces the endpoint.
+        :param pulumi.Input[str] role_name: The role whose credentials the connection URIs use. The URIs are only filled in when databaseName is set too.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] settings: Postgres settings applied to the endpoint's compute.
+        :param pulumi.Input[int] suspend_timeout_seconds: How long, in seconds, an idle endpoint keeps running before it is suspended.
+        :param pulumi.Input[str] type: The endpoint type, read_write or read_only. Changing it replaces the endpoint.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: EndpointArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A compute endpoint on a Neon branch. Import it with an ID of the form projectId/endpointId.
+
+        :param str resource_name: The name of the resource.
+        :param EndpointArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(EndpointArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 autoscaling_limit_max_cu: Optional[pulumi.Input[float]] = None,
+                 autoscaling_limit_min_cu: Optional[pulumi.Input[float]] = None,
+                 branch_id: Optional[pulumi.Input[str]] = None,
+                 database_name: Optional[pulumi.Input[str]] = None,
+                 pooler_enabled: Optional[pulumi.Input[bool]] = None,
+                 pooler_mode: Optional[pulumi.Input[str]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 provisioner: Optional[pulumi.Input[str]] = None,
+                 region_id: Optional[pulumi.Input[str]] = None,
+                 role_name: Optional[pulumi.Input[str]] = None,
+                 settings: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 suspend_timeout_seconds: Optional[pulumi.Input[int]] = None,
+                 type: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = EndpointArgs.__new__(EndpointArgs)
+
+            __props__.__dict__["autoscaling_limit_max_cu"] = autoscaling_limit_max_cu
+            __props__.__dict__["autoscaling_limit_min_cu"] = autoscaling_limit_min_cu
+            if branch_id is None and not opts.urn:
+                raise TypeError("Missing required property 'branch_id'")
+            __props__.__dict__["branch_id"] = branch_id
+            __props__.__dict__["database_name"] = database_name
+            __props__.__dict__["pooler_enabled"] = pooler_enabled
+            __props__.__dict__["pooler_mode"] = pooler_mode
+            if project_id is None and not opts.urn:
+                raise TypeError("Missing required property 'project_id'")
+            __props__.__dict__["project_id"] = project_id
+            __props__.__dict__["provisioner"] = provisioner
+            __props__.__dict__["region_id"] = region_id
+            __props__.__dict__["role_name"] = role_name
+            __props__.__dict__["settings"] = settings
+            __props__.__dict__["suspend_timeout_seconds"] = suspend_timeout_seconds
+            if type is None and not opts.urn:
+                raise TypeError("Missing required property 'type'")
+            __props__.__dict__["type"] = type
+            __props__.__dict__["connection_uri"] = None
+            __props__.__dict__["created_at"] = None
+            __props__.__dict__["current_state"] = None
+            __props__.__dict__["endpoint_id"] = None
+            __props__.__dict__["host"] = None
+            __props__.__dict__["last_active"] = None
+            __props__.__dict__["pooled_connection_uri"] = None
+            __props__.__dict__["pooler_host"] = None
+            __props__.__dict__["port"] = None
+            __props__.__dict__["proxy_host"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["connectionUri", "pooledConnectionUri"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(Endpoint, __self__).__init__(
+            'neon:index:Endpoint',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Endpoint':
+        """
+        Get an existing Endpoint resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = EndpointArgs.__new__(EndpointArgs)
+
+        __props__.__dict__["autoscaling_limit_max_cu"] = None
+        __props__.__dict__["autoscaling_limit_min_cu"] = None
+        __props__.__dict__["branch_id"] = None
+        __props__.__dict__["connection_uri"] = None
+        __props__.__dict__["created_at"] = None
+        __props__.__dict__["current_state"] = None
+        __props__.__dict__["database_name"] = None
+        __props__.__dict__["endpoint_id"] = None
+        __props__.__dict__["host"] = None
+        __props__.__dict__["last_active"] = None
+        __props__.__dict__["pooled_connection_uri"] = None
+        __props__.__dict__["pooler_enabled"] = None
+        __props__.__dict__["pooler_host"] = None
+        __props__.__dict__["pooler_mode"] = None
+        __props__.__dict__["port"] = None
+        __props__.__dict__["project_id"] = None
+        __props__.__dict__["provisioner"] = None
+        __props__.__dict__["proxy_host"] = None
+        __props__.__dict__["region_id"] = None
+        __props__.__dict__["role_name"] = None
+        __props__.__dict__["settings"] = None
+        __props__.__dict__["suspend_timeout_seconds"] = None
+        __props__.__dict__["type"] = None
+        return Endpoint(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="autoscalingLimitMaxCu")
+    def autoscaling_limit_max_cu(self) -> pulumi.Output[Optional[float]]:
+        """
+        The maximum compute size, in compute units.
+        """
+        return pulumi.get(self, "autoscaling_limit_max_cu")
+
+    @property
+    @pulumi.getter(name="autoscalingLimitMinCu")
+    def autoscaling_limit_min_cu(self) -> pulumi.Output[Optional[float]]:
+        """
+        The minimum compute size, in compute units.
+        """
+        return pulumi.get(self, "autoscaling_limit_min_cu")
+
+    @property
+    @pulumi.getter(name="branchId")
+    def branch_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the branch the endpoint serves. Changing it moves the endpoint to that branch.
+        """
+        return pulumi.get(self, "branch_id")
+
+    @property
+    @pulumi.getter(name="connectionUri")
+    def connection_uri(self) -> pulumi.Output[Optional[str]]:
+        """
+        A connection URI for databaseName as roleName. It embeds the role's password.
+        """
+        return pulumi.get(self, "connection_uri")
+
+    @property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> pulumi.Output[str]:
+        """
+        When the endpoint was created, in RFC 3339 format.
+        """
+        return pulumi.get(self, "created_at")
+
+    @property
+    @pulumi.getter(name="currentState")
+    def current_state(self) -> pulumi.Output[Optional[str]]:
+        """
+        The state of the endpoint's compute, such as active or idle.
+        """
+        return pulumi.get(self, "current_state")
+
+    @property
+    @pulumi.getter(name="databaseName")
+    def database_name(self) -> pulumi.Output[Optional[str]]:
+        """
+        The database the connection URIs connect to. The URIs are only filled in when roleName is set too.
+        """
+        return pulumi.get(self, "database_name")
+
+    @property
+    @pulumi.getter(name="endpointId")
+    def endpoint_id(self) -> pulumi.Output[str]:
+        """
+        The ID Neon assigned to the endpoint.
+        """
+        return pulumi.get(self, "endpoint_id")
+
+    @property
+    @pulumi.getter
+    def host(self) -> pulumi.Output[str]:
+        """
+        The hostname of the endpoint.
+        """
+        return pulumi.get(self, "host")
+
+    @property
+    @pulumi.getter(name="lastActive")
+    def last_active(self) -> pulumi.Output[Optional[str]]:
+        """
+        When the endpoint was last active, in RFC 3339 format.
+        """
+        return pulumi.get(self, "last_active")
+
+    @property
+    @pulumi.getter(name="pooledConnectionUri")
+    def pooled_connection_uri(self) -> pulumi.Output[Optional[str]]:
+        """
+        A connection URI for databaseName as roleName that goes through the connection pooler. It embeds the role's password.
+        """
+        return pulumi.get(self, "pooled_connection_uri")
+
+    @property
+    @pulumi.getter(name="poolerEnabled")
+    def pooler_enabled(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether connections can go through the PgBouncer connection pooler.
+        """
+        return pulumi.get(self, "pooler_enabled")
+
+    @property
+    @pulumi.getter(name="poolerHost")
+    def pooler_host(self) -> pulumi.Output[Optional[str]]:
+        """
+        The hostname that routes connections through PgBouncer.
+        """
+        return pulumi.get(self, "pooler_host")
+
+    @property
+    @pulumi.getter(name="poolerMode")
+    def pooler_mode(self) -> pulumi.Output[Optional[str]]:
+        """
+        The connection pooler mode, such as transaction.
+        """
+        return pulumi.get(self, "pooler_mode")
+
+    @property
+    @pulumi.getter
+    def port(self) -> pulumi.Output[Optional[int]]:
+        """
+        The port the endpoint accepts connections on.
+        """
+        return pulumi.get(self, "port")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the project the endpoint belongs to. Changing it replaces the endpoint.
+        """
+        return pulumi.get(self, "project_id")
+
+    @property
+    @pulumi.getter
+    def provisioner(self) -> pulumi.Output[Optional[str]]:
+        """
+        The compute provisioner, k8s-pod or k8s-neonvm.
+        """
+        return pulumi.get(self, "provisioner")
+
+    @property
+    @pulumi.getter(name="proxyHost")
+    def proxy_host(self) -> pulumi.Output[Optional[str]]:
+        """
+        The hostname of the proxy in front of the endpoint.
+        """
+        return pulumi.get(self, "proxy_host")
+
+    @property
+    @pulumi.getter(name="regionId")
+    def region_id(self) -> pulumi.Output[Optional[str]]:
+        """
+        The region of the endpoint, which must match the project's region. Changing it replaces the endpoint.
+        """
+        return pulumi.get(self, "region_id")
+
+    @property
+    @pulumi.getter(name="roleName")
+    def role_name(self) -> pulumi.Output[Optional[str]]:
+        """
+        The role whose credentials the connection URIs use. The URIs are only filled in when databaseName is set too.
+        """
+        return pulumi.get(self, "role_name")
+
+    @property
+    @pulumi.getter
+    def settings(self) -> pulumi.Output[Optional[Mapping[str, str]]]:
+        """
+        Postgres settings applied to the endpoint's compute.
+        """
+        return pulumi.get(self, "settings")
+
+    @property
+    @pulumi.getter(name="suspendTimeoutSeconds")
+    def suspend_timeout_seconds(self) -> pulumi.Output[Optional[int]]:
+        """
+        How long, in seconds, an idle endpoint keeps running before it is suspended.
+        """
+        return pulumi.get(self, "suspend_timeout_seconds")
+
+    @property
+    @pulumi.getter
+    def type(self) -> pulumi.Output[str]:
+        """
+        The endpoint type, read_write or read_only. Changing it replaces the endpoint.
+        """
+        return pulumi.get(self, "type")
+
@@ -0,0 +1,153 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = [
+    'GetBranchResult',
+    'AwaitableGetBranchResult',
+    'get_branch',
+    'get_branch_output',
+]
+
+@pulumi.output_type
+class GetBranchResult:
+    def __init__(__self__, branch_id=None, created_at=None, name=None, parent_id=None, parent_lsn=None, parent_timestamp=None, project_id=None):
+        if branch_id and not isinstance(branch_id, str):
+            raise TypeError("Expected argument 'branch_id' to be a str")
+        pulumi.set(__self__, "branch_id", branch_id)
+        if created_at and not isinstance(created_at, str):
+            raise TypeError("Expected argument 'created_at' to be a str")
+        pulumi.set(__self__, "created_at", created_at)
+        if name and not isinstance(name, str):
+            raise TypeError("Expected argument 'name' to be a str")
+        pulumi.set(__self__, "name", name)
+        if parent_id and not isinstance(parent_id, str):
+            raise TypeError("Expected argument 'parent_id' to be a str")
+        pulumi.set(__self__, "parent_id", parent_id)
+        if parent_lsn and not isinstance(parent_lsn, str):
+            raise TypeError("Expected argument 'parent_lsn' to be a str")
+        pulumi.set(__self__, "parent_lsn", parent_lsn)
+        if parent_timestamp and not isinstance(parent_timestamp, str):
+            raise TypeError("Expected argument 'parent_timestamp' to be a str")
+        pulumi.set(__self__, "parent_timestamp", parent_timestamp)
+        if project_id and not isinstance(project_id, str):
+            raise TypeError("Expected argument 'project_id' to be a str")
+        pulumi.set(__self__, "project_id", project_id)
+
+    @property
+    @pulumi.getter(name="branchId")
+    def branch_id(self) -> str:
+        """
+        The ID of the branch.
+        """
+        return pulumi.get(self, "branch_id")
+
+    @property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> str:
+        """
+        When the branch was created, in RFC 3339 format.
+        """
+        return pulumi.get(self, "created_at")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        The name of the branch.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="parentId")
+    def parent_id(self) -> Optional[str]:
+        """
+        The ID of the branch it was branched from.
+        """
+        return pulumi.get(self, "parent_id")
+
+    @property
+    @pulumi.getter(name="parentLsn")
+    def parent_lsn(self) -> Optional[str]:
+        """
+        The Log Sequence Number of the parent it was branched from.
+        """
+        return pulumi.get(self, "parent_lsn")
+
+    @property
+    @pulumi.getter(name="parentTimestamp")
+    def parent_timestamp(self) -> Optional[str]:
+        """
+        The point in time of the parent it was branched from.
+        """
+        return pulumi.get(self, "parent_timestamp")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> str:
+        """
+        The ID of the project the branch belongs to.
+        """
+        return pulumi.get(self, "project_id")
+
+
+class AwaitableGetBranchResult(GetBranchResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetBranchResult(
+            branch_id=self.branch_id,
+            created_at=self.created_at,
+            name=self.name,
+            parent_id=self.parent_id,
+            parent_lsn=self.parent_lsn,
+            parent_timestamp=self.parent_timestamp,
+            project_id=self.project_id)
+
+
+def get_branch(name: Optional[str] = None,
+               project_id: Optional[str] = None,
+               opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetBranchResult:
+    """
+    Look up a branch of a Neon project by its name.
+
+
+    :param str name: The name of the branch to look up.
+    :param str project_id: The ID of the project the branch belongs to.
+    """
+    __args__ = dict()
+    __args__['name'] = name
+    __args__['projectId'] = project_id
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('neon:index:getBranch', __args__, opts=opts, typ=GetBranchResult).value
+
+    return AwaitableGetBranchResult(
+        branch_id=pulumi.get(__ret__, 'branch_id'),
+        created_at=pulumi.get(__ret__, 'created_at'),
+        name=pulumi.get(__ret__, 'name'),
+        parent_id=pulumi.get(__ret__, 'parent_id'),
+        parent_lsn=pulumi.get(__ret__, 'parent_lsn'),
+        parent_timestamp=pulumi.get(__ret__, 'parent_timestamp'),
+        project_id=pulumi.get(__ret__, 'project_id'))
+
+
+@_utilities.lift_output_func(get_branch)
+def get_branch_output(name: Optional[pulumi.Input[str]] = None,
+                      project_id: Optional[pulumi.Input[str]] = None,
+                      opts: Optional[pulumi.InvokeOptions] = None) -> pulumi.Output[GetBranchResult]:
+    """
+    Look up a branch of a Neon project by its name.
+
+
+    :param str name: The name of the branch to look up.
+    :param str project_id: The ID of the project the branch belongs to.
+    """
+    ...
@@ -0,0 +1,76 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetEndpointsResult',
+    'AwaitableGetEndpointsResult',
+    'get_endpoints',
+    'get_endpoints_output',
+]
+
+@pulumi.output_type
+class GetEndpointsResult:
+    def __init__(__self__, endpoints=None):
+        if endpoints and not isinstance(endpoints, list):
+            raise TypeError("Expected argument 'endpoints' to be a list")
+        pulumi.set(__self__, "endpoints", endpoints)
+
+    @property
+    @pulumi.getter
+    def endpoints(self) -> Sequence['outputs.EndpointSummary']:
+        """
+        The endpoints on the branch.
+        """
+        return pulumi.get(self, "endpoints")
+
+
+class AwaitableGetEndpointsResult(GetEndpointsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetEndpointsResult(
+            endpoints=self.endpoints)
+
+
+def get_endpoints(branch_id: Optional[str] = None,
+                  project_id: Optional[str] = None,
+                  opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetEndpointsResult:
+    """
+    List the compute endpoints on a branch of a Neon project.
+
+
+    :param str branch_id: The ID of the branch whose endpoints are listed.
+    :param str project_id: The ID of the project the branch belongs to.
+    """
+    __args__ = dict()
+    __args__['branchId'] = branch_id
+    __args__['projectId'] = project_id
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('neon:index:getEndpoints', __args__, opts=opts, typ=GetEndpointsResult).value
+
+    return AwaitableGetEndpointsResult(
+        endpoints=pulumi.get(__ret__, 'endpoints'))
+
+
+@_utilities.lift_output_func(get_endpoints)
+def get_endpoints_output(branch_id: Optional[pulumi.Input[str]] = None,
+                         project_id: Optional[pulumi.Input[str]] = None,
+                         opts: Optional[pulumi.InvokeOptions] = None) -> pulumi.Output[GetEndpointsResult]:
+    """
+    List the compute endpoints on a branch of a Neon project.
+
+
+    :param str branch_id: The ID of the branch whose endpoints are listed.
+    :param str project_id: The ID of the project the branch belongs to.
+    """
+    ...
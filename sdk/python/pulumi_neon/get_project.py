@@ -0,0 +1,198 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetProjectResult',
+    'AwaitableGetProjectResult',
+    'get_project',
+    'get_project_output',
+]
+
+@pulumi.output_type
+class GetProjectResult:
+    def __init__(__self__, created_at=None, default_endpoint_settings=None, history_retention_seconds=None, name=None, org_id=None, pg_version=None, project_id=None, provisioner=None, region_id=None, store_passwords=None):
+        if created_at and not isinstance(created_at, str):
+            raise TypeError("Expected argument 'created_at' to be a str")
+        pulumi.set(__self__, "created_at", created_at)
+        if default_endpoint_settings and not isinstance(default_endpoint_settings, dict):
+            raise TypeError("Expected argument 'default_endpoint_settings' to be a dict")
+        pulumi.set(__self__, "default_endpoint_settings", default_endpoint_settings)
+        if history_retention_seconds and not isinstance(history_retention_seconds, int):
+            raise TypeError("Expected argument 'history_retention_seconds' to be a int")
+        pulumi.set(__self__, "history_retention_seconds", history_retention_seconds)
+        if name and not isinstance(name, str):
+            raise TypeError("Expected argument 'name' to be a str")
+        pulumi.set(__self__, "name", name)
+        if org_id and not isinstance(org_id, str):
+            raise TypeError("Expected argument 'org_id' to be a str")
+        pulumi.set(__self__, "org_id", org_id)
+        if pg_version and not isinstance(pg_version, int):
+            raise TypeError("Expected argument 'pg_version' to be a int")
+        pulumi.set(__self__, "pg_version", pg_version)
+        if project_id and not isinstance(project_id, str):
+            raise TypeError("Expected argument 'project_id' to be a str")
+        pulumi.set(__self__, "project_id", project_id)
+        if provisioner and not isinstance(provisioner, str):
+            raise TypeError("Expected argument 'provisioner' to be a str")
+        pulumi.set(__self__, "provisioner", provisioner)
+        if region_id and not isinstance(region_id, str):
+            raise TypeError("Expected argument 'region_id' to be a str")
+        pulumi.set(__self__, "region_id", region_id)
+        if store_passwords and not isinstance(store_passwords, bool):
+            raise TypeError("Expected argument 'store_passwords' to be a bool")
+        pulumi.set(__self__, "store_passwords", store_passwords)
+
+    @property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> str:
+        """
+        When the project was created, in RFC 3339 format.
+        """
+        return pulumi.get(self, "created_at")
+
+    @property
+    @pulumi.getter(name="defaultEndpointSettings")
+    def default_endpoint_settings(self) -> Optional['outputs.DefaultEndpointSettings']:
+        """
+        The compute settings applied to endpoints created in the project.
+        """
+        return pulumi.get(self, "default_endpoint_settings")
+
+    @property
+    @pulumi.getter(name="historyRetentionSeconds")
+    def history_retention_seconds(self) -> Optional[int]:
+        """
+        How long, in seconds, Neon keeps the history that branches can be created from.
+        """
+        return pulumi.get(self, "history_retention_seconds")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        The name of the project.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="orgId")
+    def org_id(self) -> Optional[str]:
+        """
+        The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+        """
+        return pulumi.get(self, "org_id")
+
+    @property
+    @pulumi.getter(name="pgVersion")
+    def pg_version(self) -> Optional[int]:
+        """
+        The major Postgres version. Changing it replaces the project.
+        """
+        return pulumi.get(self, "pg_version")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> str:
+        """
+        The ID of the project.
+        """
+        return pulumi.get(self, "project_id")
+
+    @property
+    @pulumi.getter
+    def provisioner(self) -> Optional[str]:
+        """
+        The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+        """
+        return pulumi.get(self, "provisioner")
+
+    @property
+    @pulumi.getter(name="regionId")
+    def region_id(self) -> str:
+        """
+        The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+        """
+        return pulumi.get(self, "region_id")
+
+    @property
+    @pulumi.getter(name="storePasswords")
+    def store_passwords(self) -> Optional[bool]:
+        """
+        Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+        """
+        return pulumi.get(self, "store_passwords")
+
+
+class AwaitableGetProjectResult(GetProjectResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetProjectResult(
+            created_at=self.created_at,
+            default_endpoint_settings=self.default_endpoint_settings,
+            history_retention_seconds=self.history_retention_seconds,
+            name=self.name,
+            org_id=self.org_id,
+            pg_version=self.pg_version,
+            project_id=self.project_id,
+            provisioner=self.provisioner,
+            region_id=self.region_id,
+            store_passwords=self.store_passwords)
+
+
+def get_project(name: Optional[str] = None,
+                org_id: Optional[str] = None,
+                project_id: Optional[str] = None,
+                opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetProjectResult:
+    """
+    Look up a Neon project by its ID or, within an organization, by its name.
+
+
+    :param str name: The name of the project to look up. Exactly one of projectId and name must be set.
+    :param str org_id: The organization searched for a project by name. Defaults to the provider's orgId.
+    :param str project_id: The ID of the project to look up. Exactly one of projectId and name must be set.
+    """
+    __args__ = dict()
+    __args__['name'] = name
+    __args__['orgId'] = org_id
+    __args__['projectId'] = project_id
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('neon:index:getProject', __args__, opts=opts, typ=GetProjectResult).value
+
+    return AwaitableGetProjectResult(
+        created_at=pulumi.get(__ret__, 'created_at'),
+        default_endpoint_settings=pulumi.get(__ret__, 'default_endpoint_settings'),
+        history_retention_seconds=pulumi.get(__ret__, 'history_retention_seconds'),
+        name=pulumi.get(__ret__, 'name'),
+        org_id=pulumi.get(__ret__, 'org_id'),
+        pg_version=pulumi.get(__ret__, 'pg_version'),
+        project_id=pulumi.get(__ret__, 'project_id'),
+        provisioner=pulumi.get(__ret__, 'provisioner'),
+        region_id=pulumi.get(__ret__, 'region_id'),
+        store_passwords=pulumi.get(__ret__, 'store_passwords'))
+
+
+@_utilities.lift_output_func(get_project)
+def get_project_output(name: Optional[pulumi.Input[Optional[str]]] = None,
+                       org_id: Optional[pulumi.Input[Optional[str]]] = None,
+                       project_id: Optional[pulumi.Input[Optional[str]]] = None,
+                       opts: Optional[pulumi.InvokeOptions] = None) -> pulumi.Output[GetProjectResult]:
+    """
+    Look up a Neon project by its ID or, within an organization, by its name.
+
+
+    :param str name: The name of the project to look up. Exactly one of projectId and name must be set.
+    :param str org_id: The organization searched for a project by name. Defaults to the provider's orgId.
+    :param str project_id: The ID of the project to look up. Exactly one of projectId and name must be set.
+    """
+    ...
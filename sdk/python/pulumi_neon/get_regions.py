@@ -0,0 +1,62 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetRegionsResult',
+    'AwaitableGetRegionsResult',
+    'get_regions',
+    'get_regions_output',
+]
+
+@pulumi.output_type
+class GetRegionsResult:
+    def __init__(__self__, regions=None):
+        if regions and not isinstance(regions, list):
+            raise TypeError("Expected argument 'regions' to be a list")
+        pulumi.set(__self__, "regions", regions)
+
+    @property
+    @pulumi.getter
+    def regions(self) -> Sequence['outputs.Region']:
+        """
+        The regions Neon can create projects in.
+        """
+        return pulumi.get(self, "regions")
+
+
+class AwaitableGetRegionsResult(GetRegionsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetRegionsResult(
+            regions=self.regions)
+
+
+def get_regions(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetRegionsResult:
+    """
+    List the regions Neon can create projects in.
+    """
+    __args__ = dict()
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('neon:index:getRegions', __args__, opts=opts, typ=GetRegionsResult).value
+
+    return AwaitableGetRegionsResult(
+        regions=pulumi.get(__ret__, 'regions'))
+
+
+@_utilities.lift_output_func(get_regions)
+def get_regions_output(opts: Optional[pulumi.InvokeOptions] = None) -> pulumi.Output[GetRegionsResult]:
+    """
+    List the regions Neon can create projects in.
+    """
+    ...
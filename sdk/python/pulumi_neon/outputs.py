@@ -0,0 +1,418 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = [
+    'BranchEndpoint',
+    'CreatedEndpoint',
+    'DefaultEndpointSettings',
+    'EndpointSummary',
+    'Region',
+]
+
+@pulumi.output_type
+class BranchEndpoint(dict):
+    """
+    A compute endpoint created along with its branch.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "autoscalingLimitMaxCu":
+            suggest = "autoscaling_limit_max_cu"
+        elif key == "autoscalingLimitMinCu":
+            suggest = "autoscaling_limit_min_cu"
+        elif key == "suspendTimeoutSeconds":
+            suggest = "suspend_timeout_seconds"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in BranchEndpoint. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        BranchEndpoint.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        BranchEndpoint.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 type: str,
+                 autoscaling_limit_max_cu: Optional[float] = None,
+                 autoscaling_limit_min_cu: Optional[float] = None,
+                 provisioner: Optional[str] = None,
+                 suspend_timeout_seconds: Optional[int] = None):
+        """
+        A compute endpoint created along with its branch.
+        :param str type: The endpoint type, read_write or read_only.
+        :param float autoscaling_limit_max_cu: The maximum compute size, in compute units.
+        :param float autoscaling_limit_min_cu: The minimum compute size, in compute units.
+        :param str provisioner: The compute provisioner, k8s-pod or k8s-neonvm.
+        :param int suspend_timeout_seconds: How long, in seconds, an idle endpoint keeps running before it is suspended.
+        """
+        pulumi.set(__self__, "type", type)
+        if autoscaling_limit_max_cu is not None:
+            pulumi.set(__self__, "autoscaling_limit_max_cu", autoscaling_limit_max_cu)
+        if autoscaling_limit_min_cu is not None:
+            pulumi.set(__self__, "autoscaling_limit_min_cu", autoscaling_limit_min_cu)
+        if provisioner is not None:
+            pulumi.set(__self__, "provisioner", provisioner)
+        if suspend_timeout_seconds is not None:
+            pulumi.set(__self__, "suspend_timeout_seconds", suspend_timeout_seconds)
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        """
+        The endpoint type, read_write or read_only.
+        """
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter(name="autoscalingLimitMaxCu")
+    def autoscaling_limit_max_cu(self) -> Optional[float]:
+        """
+        The maximum compute size, in compute units.
+        """
+        return pulumi.get(self, "autoscaling_limit_max_cu")
+
+    @property
+    @pulumi.getter(name="autoscalingLimitMinCu")
+    def autoscaling_limit_min_cu(self) -> Optional[float]:
+        """
+        The minimum compute size, in compute units.
+        """
+        return pulumi.get(self, "autoscaling_limit_min_cu")
+
+    @property
+    @pulumi.getter
+    def provisioner(self) -> Optional[str]:
+        """
+        The compute provisioner, k8s-pod or k8s-neonvm.
+        """
+        return pulumi.get(self, "provisioner")
+
+    @property
+    @pulumi.getter(name="suspendTimeoutSeconds")
+    def suspend_timeout_seconds(self) -> Optional[int]:
+        """
+        How long, in seconds, an idle endpoint keeps running before it is suspended.
+        """
+        return pulumi.get(self, "suspend_timeout_seconds")
+
+
+@pulumi.output_type
+class CreatedEndpoint(dict):
+    """
+    An endpoint created along with its branch.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "endpointId":
+            suggest = "endpoint_id"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in CreatedEndpoint. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        CreatedEndpoint.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        CreatedEndpoint.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 endpoint_id: str,
+                 host: str,
+                 type: str):
+        """
+        An endpoint created along with its branch.
+        :param str endpoint_id: The ID Neon assigned to the endpoint.
+        :param str host: The hostname of the endpoint.
+        :param str type: The endpoint type, read_write or read_only.
+        """
+        pulumi.set(__self__, "endpoint_id", endpoint_id)
+        pulumi.set(__self__, "host", host)
+        pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter(name="endpointId")
+    def endpoint_id(self) -> str:
+        """
+        The ID Neon assigned to the endpoint.
+        """
+        return pulumi.get(self, "endpoint_id")
+
+    @property
+    @pulumi.getter
+    def host(self) -> str:
+        """
+        The hostname of the endpoint.
+        """
+        return pulumi.get(self, "host")
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        """
+        The endpoint type, read_write or read_only.
+        """
+        return pulumi.get(self, "type")
+
+
+@pulumi.output_type
+class DefaultEndpointSettings(dict):
+    """
+    Compute settings applied to the endpoints created in a project.
+    """
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "autoscalingLimitMaxCu":
+            suggest = "autoscaling_limit_max_cu"
+        elif key == "autoscalingLimitMinCu":
+            suggest = "autoscaling_limit_min_cu"
+        elif key == "suspendTimeoutSeconds":
+            suggest = "suspend_timeout_seconds"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in DefaultEndpointSettings. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        DefaultEndpointSettings.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        DefaultEndpointSettings.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 autoscaling_limit_max_cu: Optional[float] = None,
+                 autoscaling_limit_min_cu: Optional[float] = None,
+                 suspend_timeout_seconds: Optional[int] = None):
+        """
+        Compute settings applied to the endpoints created in a project.
+        :param float autoscaling_limit_max_cu: The maximum compute size, in compute units.
+        :param float autoscaling_limit_min_cu: The minimum compute size, in compute units.
+        :param int suspend_timeout_seconds: How long, in seconds, an idle endpoint keeps running before it is suspended.
+        """
+        if autoscaling_limit_max_cu is not None:
+            pulumi.set(__self__, "autoscaling_limit_max_cu", autoscaling_limit_max_cu)
+        if autoscaling_limit_min_cu is not None:
+            pulumi.set(__self__, "autoscaling_limit_min_cu", autoscaling_limit_min_cu)
+        if suspend_timeout_seconds is not None:
+            pulumi.set(__self__, "suspend_timeout_seconds", suspend_timeout_seconds)
+
+    @property
+    @pulumi.getter(name="autoscalingLimitMaxCu")
+    def autoscaling_limit_max_cu(self) -> Optional[float]:
+        """
+        The maximum compute size, in compute units.
+        """
+        return pulumi.get(self, "autoscaling_limit_max_cu")
+
+    @property
+    @pulumi.getter(name="autoscalingLimitMinCu")
+    def autoscaling_limit_min_cu(self) -> Optional[float]:
+        """
+        The minimum compute size, in compute units.
+        """
+        return pulumi.get(self, "autoscaling_limit_min_cu")
+
+    @property
+    @pulumi.getter(name="suspendTimeoutSeconds")
+    def suspend_timeout_seconds(self) -> Optional[int]:
+        """
+        How long, in seconds, an idle endpoint keeps running before it is suspended.
+        """
+        return pulumi.get(self, "suspend_timeout_seconds")
+
+
+@pulumi.output_type
+class EndpointSummary(dict):
+    """
+    A compute endpoint found by getEndpoints.
+    """
+    def __init__(__self__, *,
+                 autoscaling_limit_max_cu: float,
+                 autoscaling_limit_min_cu: float,
+                 created_at: str,
+                 endpoint_id: str,
+                 host: str,
+                 port: int,
+                 suspend_timeout_seconds: int,
+                 type: str,
+                 current_state: Optional[str] = None,
+                 pooler_host: Optional[str] = None,
+                 region_id: Optional[str] = None):
+        """
+        A compute endpoint found by getEndpoints.
+        :param float autoscaling_limit_max_cu: The maximum compute size, in compute units.
+        :param float autoscaling_limit_min_cu: The minimum compute size, in compute units.
+        :param str created_at: When the endpoint was created, in RFC 3339 format.
+        :param str endpoint_id: The ID of the endpoint.
+        :param str host: The hostname of the endpoint.
+        :param int port: The port the endpoint accepts connections on.
+        :param int suspend_timeout_seconds: How long, in seconds, an idle endpoint keeps running before it is suspended.
+        :param str type: The endpoint type, read_write or read_only.
+        :param str current_state: The state of the endpoint's compute, such as active or idle.
+        :param str pooler_host: The hostname that routes connections through PgBouncer.
+        :param str region_id: The region of the endpoint.
+        """
+        pulumi.set(__self__, "autoscaling_limit_max_cu", autoscaling_limit_max_cu)
+        pulumi.set(__self__, "autoscaling_limit_min_cu", autoscaling_limit_min_cu)
+        pulumi.set(__self__, "created_at", created_at)
+        pulumi.set(__self__, "endpoint_id", endpoint_id)
+        pulumi.set(__self__, "host", host)
+        pulumi.set(__self__, "port", port)
+        pulumi.set(__self__, "suspend_timeout_seconds", suspend_timeout_seconds)
+        pulumi.set(__self__, "type", type)
+        if current_state is not None:
+            pulumi.set(__self__, "current_state", current_state)
+        if pooler_host is not None:
+            pulumi.set(__self__, "pooler_host", pooler_host)
+        if region_id is not None:
+            pulumi.set(__self__, "region_id", region_id)
+
+    @property
+    @pulumi.getter(name="autoscalingLimitMaxCu")
+    def autoscaling_limit_max_cu(self) -> float:
+        """
+        The maximum compute size, in compute units.
+        """
+        return pulumi.get(self, "autoscaling_limit_max_cu")
+
+    @property
+    @pulumi.getter(name="autoscalingLimitMinCu")
+    def autoscaling_limit_min_cu(self) -> float:
+        """
+        The minimum compute size, in compute units.
+        """
+        return pulumi.get(self, "autoscaling_limit_min_cu")
+
+    @property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> str:
+        """
+        When the endpoint was created, in RFC 3339 format.
+        """
+        return pulumi.get(self, "created_at")
+
+    @property
+    @pulumi.getter(name="endpointId")
+    def endpoint_id(self) -> str:
+        """
+        The ID of the endpoint.
+        """
+        return pulumi.get(self, "endpoint_id")
+
+    @property
+    @pulumi.getter
+    def host(self) -> str:
+        """
+        The hostname of the endpoint.
+        """
+        return pulumi.get(self, "host")
+
+    @property
+    @pulumi.getter
+    def port(self) -> int:
+        """
+        The port the endpoint accepts connections on.
+        """
+        return pulumi.get(self, "port")
+
+    @property
+    @pulumi.getter(name="suspendTimeoutSeconds")
+    def suspend_timeout_seconds(self) -> int:
+        """
+        How long, in seconds, an idle endpoint keeps running before it is suspended.
+        """
+        return pulumi.get(self, "suspend_timeout_seconds")
+
+    @property
+    @pulumi.getter
+    def type(self) -> str:
+        """
+        The endpoint type, read_write or read_only.
+        """
+        return pulumi.get(self, "type")
+
+    @property
+    @pulumi.getter(name="currentState")
+    def current_state(self) -> Optional[str]:
+        """
+        The state of the endpoint's compute, such as active or idle.
+        """
+        return pulumi.get(self, "current_state")
+
+    @property
+    @pulumi.getter(name="poolerHost")
+    def pooler_host(self) -> Optional[str]:
+        """
+        The hostname that routes connections through PgBouncer.
+        """
+        return pulumi.get(self, "pooler_host")
+
+    @property
+    @pulumi.getter(name="regionId")
+    def region_id(self) -> Optional[str]:
+        """
+        The region of the endpoint.
+        """
+        return pulumi.get(self, "region_id")
+
+
+@pulumi.output_type
+class Region(dict):
+    """
+    A region Neon can create projects in.
+    """
+    def __init__(__self__, *,
+                 default: bool,
+                 name: str,
+                 region_id: str):
+        """
+        A region Neon can create projects in.
+        :param bool default: Whether projects are created in this region when they do not set one.
+        :param str name: The human readable name of the region.
+        :param str region_id: The ID of the region, such as aws-us-east-2.
+        """
+        pulumi.set(__self__, "default", default)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "region_id", region_id)
+
+    @property
+    @pulumi.getter
+    def default(self) -> bool:
+        """
+        Whether projects are created in this region when they do not set one.
+        """
+        return pulumi.get(self, "default")
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        The human readable name of the region.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="regionId")
+    def region_id(self) -> str:
+        """
+        The ID of the region, such as aws-us-east-2.
+        """
+        return pulumi.get(self, "region_id")
+
+
@@ -0,0 +1,278 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['PreviewDatabaseArgs', 'PreviewDatabase']
+
+@pulumi.input_type
+class PreviewDatabaseArgs:
+    def __init__(__self__, *,
+                 name: pulumi.Input[str],
+                 project_id: pulumi.Input[str],
+                 database_name: Optional[pulumi.Input[str]] = None,
+                 parent_branch_id: Optional[pulumi.Input[str]] = None,
+                 role_name: Optional[pulumi.Input[str]] = None,
+                 ttl: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a PreviewDatabase resource.
+        :param pulumi.Input[str] name: The name of the preview's branch.
+        :param pulumi.Input[str] project_id: The ID of the project to create the preview in.
+        :param pulumi.Input[str] database_name: The database created on the branch. It must not already exist on the parent branch.
+        :param pulumi.Input[str] parent_branch_id: The branch the preview is copied from. Defaults to the project's default branch.
+        :param pulumi.Input[str] role_name: The role created on the branch. It must not already exist on the parent branch.
+        :param pulumi.Input[str] ttl: How long the preview lives, as a duration such as 72h. Neon deletes the branch once it expires.
+        """
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "project_id", project_id)
+        if database_name is not None:
+            pulumi.set(__self__, "database_name", database_name)
+        if parent_branch_id is not None:
+            pulumi.set(__self__, "parent_branch_id", parent_branch_id)
+        if role_name is not None:
+            pulumi.set(__self__, "role_name", role_name)
+        if ttl is not None:
+            pulumi.set(__self__, "ttl", ttl)
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[str]:
+        """
+        The name of the preview's branch.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[str]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Input[str]:
+        """
+        The ID of the project to create the preview in.
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "project_id", value)
+
+    @property
+    @pulumi.getter(name="databaseName")
+    def database_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The database created on the branch. It must not already exist on the parent branch.
+        """
+        return pulumi.get(self, "database_name")
+
+    @database_name.setter
+    def database_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "database_name", value)
+
+    @property
+    @pulumi.getter(name="parentBranchId")
+    def parent_branch_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The branch the preview is copied from. Defaults to the project's default branch.
+        """
+        return pulumi.get(self, "parent_branch_id")
+
+    @parent_branch_id.setter
+    def parent_branch_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "parent_branch_id", value)
+
+    @property
+    @pulumi.getter(name="roleName")
+    def role_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The role created on the branch. It must not already exist on the parent branch.
+        """
+        return pulumi.get(self, "role_name")
+
+    @role_name.setter
+    def role_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "role_name", value)
+
+    @property
+    @pulumi.getter
+    def ttl(self) -> Optional[pulumi.Input[str]]:
+        """
+        How long the preview lives, as a duration such as 72h. Neon deletes the branch once it expires.
+        """
+        return pulumi.get(self, "ttl")
+
+    @ttl.setter
+    def ttl(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "ttl", value)
+
+
+class PreviewDatabase(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 database_name: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 parent_branch_id: Optional[pulumi.Input[str]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 role_name: Optional[pulumi.Input[str]] = None,
+                 ttl: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        A branch of a Neon project with its own compute endpoint, role and database, for short-lived preview environments.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] database_name: The database created on the branch. It must not already exist on the parent branch.
+        :param pulumi.Input[str] name: The name of the preview's branch.
+        :param pulumi.Input[str] parent_branch_id: The branch the preview is copied from. Defaults to the project's default branch.
+        :param pulumi.Input[str] project_id: The ID of the project to create the preview in.
+        :param pulumi.Input[str] role_name: The role created on the branch. It must not already exist on the parent branch.
+        :param pulumi.Input[str] ttl: How long the preview lives, as a duration such as 72h. Neon deletes the branch once it expires.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: PreviewDatabaseArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A branch of a Neon project with its own compute endpoint, role and database, for short-lived preview environments.
+
+        :param str resource_name: The name of the resource.
+        :param PreviewDatabaseArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(PreviewDatabaseArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 database_name: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 parent_branch_id: Optional[pulumi.Input[str]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 role_name: Optional[pulumi.Input[str]] = None,
+                 ttl: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = PreviewDatabaseArgs.__new__(PreviewDatabaseArgs)
+
+            __props__.__dict__["database_name"] = database_name
+            if name is None and not opts.urn:
+                raise TypeError("Missing required property 'name'")
+            __props__.__dict__["name"] = name
+            __props__.__dict__["parent_branch_id"] = parent_branch_id
+            if project_id is None and not opts.urn:
+                raise TypeError("Missing required property 'project_id'")
+            __props__.__dict__["project_id"] = project_id
+            __props__.__dict__["role_name"] = role_name
+            __props__.__dict__["ttl"] = ttl
+            __props__.__dict__["branch_id"] = None
+            __props__.__dict__["created_at"] = None
+            __props__.__dict__["dsn"] = None
+            __props__.__dict__["endpoint_id"] = None
+            __props__.__dict__["expires_at"] = None
+            __props__.__dict__["host"] = None
+            __props__.__dict__["pooled_dsn"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["dsn", "pooledDsn"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(PreviewDatabase, __self__).__init__(
+            'neon:index:PreviewDatabase',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter(name="branchId")
+    def branch_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the preview's branch.
+        """
+        return pulumi.get(self, "branch_id")
+
+    @property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> pulumi.Output[str]:
+        """
+        When the preview's branch was created, in RFC 3339 format.
+        """
+        return pulumi.get(self, "created_at")
+
+    @property
+    @pulumi.getter(name="databaseName")
+    def database_name(self) -> pulumi.Output[str]:
+        """
+        The database created on the branch.
+        """
+        return pulumi.get(self, "database_name")
+
+    @property
+    @pulumi.getter
+    def dsn(self) -> pulumi.Output[str]:
+        """
+        A connection URI for the database as the role.
+        """
+        return pulumi.get(self, "dsn")
+
+    @property
+    @pulumi.getter(name="endpointId")
+    def endpoint_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the preview's read-write endpoint.
+        """
+        return pulumi.get(self, "endpoint_id")
+
+    @property
+    @pulumi.getter(name="expiresAt")
+    def expires_at(self) -> pulumi.Output[Optional[str]]:
+        """
+        When the preview's ttl runs out, in RFC 3339 format.
+        """
+        return pulumi.get(self, "expires_at")
+
+    @property
+    @pulumi.getter
+    def host(self) -> pulumi.Output[str]:
+        """
+        The hostname of the preview's endpoint.
+        """
+        return pulumi.get(self, "host")
+
+    @property
+    @pulumi.getter(name="pooledDsn")
+    def pooled_dsn(self) -> pulumi.Output[str]:
+        """
+        A connection URI for the database as the role that goes through the connection pooler.
+        """
+        return pulumi.get(self, "pooled_dsn")
+
+    @property
+    @pulumi.getter(name="roleName")
+    def role_name(self) -> pulumi.Output[str]:
+        """
+        The role created on the branch.
+        """
+        return pulumi.get(self, "role_name")
+
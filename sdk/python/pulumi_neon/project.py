@@ -0,0 +1,398 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = ['ProjectArgs', 'Project']
+
+@pulumi.input_type
+class ProjectArgs:
+    def __init__(__self__, *,
+                 name: pulumi.Input[str],
+                 region_id: pulumi.Input[str],
+                 default_endpoint_settings: Optional[pulumi.Input['DefaultEndpointSettingsArgs']] = None,
+                 history_retention_seconds: Optional[pulumi.Input[int]] = None,
+                 org_id: Optional[pulumi.Input[str]] = None,
+                 pg_version: Optional[pulumi.Input[int]] = None,
+                 provisioner: Optional[pulumi.Input[str]] = None,
+                 store_passwords: Optional[pulumi.Input[bool]] = None):
+        """
+        The set of arguments for constructing a Project resource.
+        :param pulumi.Input[str] name: The name of the project.
+        :param pulumi.Input[str] region_id: The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+        :param pulumi.Input['DefaultEndpointSettingsArgs'] default_endpoint_settings: The compute settings applied to endpoints created in the project.
+        :param pulumi.Input[int] history_retention_seconds: How long, in seconds, Neon keeps the history that branches can be created from.
+        :param pulumi.Input[str] org_id: The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+        :param pulumi.Input[int] pg_version: The major Postgres version. Changing it replaces the project.
+        :param pulumi.Input[str] provisioner: The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+        :param pulumi.Input[bool] store_passwords: Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+        """
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "region_id", region_id)
+        if default_endpoint_settings is not None:
+            pulumi.set(__self__, "default_endpoint_settings", default_endpoint_settings)
+        if history_retention_seconds is not None:
+            pulumi.set(__self__, "history_retention_seconds", history_retention_seconds)
+        if org_id is not None:
+            pulumi.set(__self__, "org_id", org_id)
+        if pg_version is not None:
+            pulumi.set(__self__, "pg_version", pg_version)
+        if provisioner is not None:
+            pulumi.set(__self__, "provisioner", provisioner)
+        if store_passwords is not None:
+            pulumi.set(__self__, "store_passwords", store_passwords)
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[str]:
+        """
+        The name of the project.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[str]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter(name="regionId")
+    def region_id(self) -> pulumi.Input[str]:
+        """
+        The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+        """
+        return pulumi.get(self, "region_id")
+
+    @region_id.setter
+    def region_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "region_id", value)
+
+    @property
+    @pulumi.getter(name="defaultEndpointSettings")
+    def default_endpoint_settings(self) -> Optional[pulumi.Input['DefaultEndpointSettingsArgs']]:
+        """
+        The compute settings applied to endpoints created in the project.
+        """
+        return pulumi.get(self, "default_endpoint_settings")
+
+    @default_endpoint_settings.setter
+    def default_endpoint_settings(self, value: Optional[pulumi.Input['DefaultEndpointSettingsArgs']]):
+        pulumi.set(self, "default_endpoint_settings", value)
+
+    @property
+    @pulumi.getter(name="historyRetentionSeconds")
+    def history_retention_seconds(self) -> Optional[pulumi.Input[int]]:
+        """
+        How long, in seconds, Neon keeps the history that branches can be created from.
+        """
+        return pulumi.get(self, "history_retention_seconds")
+
+    @history_retention_seconds.setter
+    def history_retention_seconds(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "history_retention_seconds", value)
+
+    @property
+    @pulumi.getter(name="orgId")
+    def org_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+        """
+        return pulumi.get(self, "org_id")
+
+    @org_id.setter
+    def org_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "org_id", value)
+
+    @property
+    @pulumi.getter(name="pgVersion")
+    def pg_version(self) -> Optional[pulumi.Input[int]]:
+        """
+        The major Postgres version. Changing it replaces the project.
+        """
+        return pulumi.get(self, "pg_version")
+
+    @pg_version.setter
+    def pg_version(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "pg_version", value)
+
+    @property
+    @pulumi.getter
+    def provisioner(self) -> Optional[pulumi.Input[str]]:
+        """
+        The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+        """
+        return pulumi.get(self, "provisioner")
+
+    @provisioner.setter
+    def provisioner(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "provisioner", value)
+
+    @property
+    @pulumi.getter(name="storePasswords")
+    def store_passwords(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+        """
+        return pulumi.get(self, "store_passwords")
+
+    @store_passwords.setter
+    def store_passwords(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "store_passwords", value)
+
+
+class Project(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 default_endpoint_settings: Optional[pulumi.Input[pulumi.InputType['DefaultEndpointSettingsArgs']]] = None,
+                 history_retention_seconds: Optional[pulumi.Input[int]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 org_id: Optional[pulumi.Input[str]] = None,
+                 pg_version: Optional[pulumi.Input[int]] = None,
+                 provisioner: Optional[pulumi.Input[str]] = None,
+                 region_id: Optional[pulumi.Input[str]] = None,
+                 store_passwords: Optional[pulumi.Input[bool]] = None,
+                 __props__=None):
+        """
+        A Neon project. Import it with its project ID.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[pulumi.InputType['DefaultEndpointSettingsArgs']] default_endpoint_settings: The compute settings applied to endpoints created in the project.
+        :param pulumi.Input[int] history_retention_seconds: How long, in seconds, Neon keeps the history that branches can be created from.
+        :param pulumi.Input[str] name: The name of the project.
+        :param pulumi.Input[str] org_id: The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+        :param pulumi.Input[int] pg_version: The major Postgres version. Changing it replaces the project.
+        :param pulumi.Input[str] provisioner: The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+        :param pulumi.Input[str] region_id: The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+        :param pulumi.Input[bool] store_passwords: Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: ProjectArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A Neon project. Import it with its project ID.
+
+        :param str resource_name: The name of the resource.
+        :param ProjectArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(ProjectArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 default_endpoint_settings: Optional[pulumi.Input[pulumi.InputType['DefaultEndpointSettingsArgs']]] = None,
+                 history_retention_seconds: Optional[pulumi.Input[int]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 org_id: Optional[pulumi.Input[str]] = None,
+                 pg_version: Optional[pulumi.Input[int]] = None,
+                 provisioner: Optional[pulumi.Input[str]] = None,
+                 region_id: Optional[pulumi.Input[str]] = None,
+                 store_passwords: Optional[pulumi.Input[bool]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = ProjectArgs.__new__(ProjectArgs)
+
+            __props__.__dict__["default_endpoint_settings"] = default_endpoint_settings
+            __props__.__dict__["history_retention_seconds"] = history_retention_seconds
+            if name is None and not opts.urn:
+                raise TypeError("Missing required property 'name'")
+            __props__.__dict__["name"] = name
+            __props__.__dict__["org_id"] = org_id
+            __props__.__dict__["pg_version"] = pg_version
+            __props__.__dict__["provisioner"] = provisioner
+            if region_id is None and not opts.urn:
+                raise TypeError("Missing required property 'region_id'")
+            __props__.__dict__["region_id"] = region_id
+            __props__.__dict__["store_passwords"] = store_passwords
+            __props__.__dict__["connection_uri"] = None
+            __props__.__dict__["created_at"] = None
+            __props__.__dict__["default_branch_id"] = None
+            __props__.__dict__["default_database_name"] = None
+            __props__.__dict__["default_endpoint_host"] = None
+            __props__.__dict__["default_role_name"] = None
+            __props__.__dict__["project_id"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["connectionUri"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(Project, __self__).__init__(
+            'neon:index:Project',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Project':
+        """
+        Get an existing Project resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = ProjectArgs.__new__(ProjectArgs)
+
+        __props__.__dict__["connection_uri"] = None
+        __props__.__dict__["created_at"] = None
+        __props__.__dict__["default_branch_id"] = None
+        __props__.__dict__["default_database_name"] = None
+        __props__.__dict__["default_endpoint_host"] = None
+        __props__.__dict__["default_endpoint_settings"] = None
+        __props__.__dict__["default_role_name"] = None
+        __props__.__dict__["history_retention_seconds"] = None
+        __props__.__dict__["name"] = None
+        __props__.__dict__["org_id"] = None
+        __props__.__dict__["pg_version"] = None
+        __props__.__dict__["project_id"] = None
+        __props__.__dict__["provisioner"] = None
+        __props__.__dict__["region_id"] = None
+        __props__.__dict__["store_passwords"] = None
+        return Project(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="connectionUri")
+    def connection_uri(self) -> pulumi.Output[Optional[str]]:
+        """
+        A connection URI for the default database as the default role. It embeds the role's password.
+        """
+        return pulumi.get(self, "connection_uri")
+
+    @property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> pulumi.Output[str]:
+        """
+        When the project was created, in RFC 3339 format.
+        """
+        return pulumi.get(self, "created_at")
+
+    @property
+    @pulumi.getter(name="defaultBranchId")
+    def default_branch_id(self) -> pulumi.Output[Optional[str]]:
+        """
+        The ID of the branch Neon created along with the project.
+        """
+        return pulumi.get(self, "default_branch_id")
+
+    @property
+    @pulumi.getter(name="defaultDatabaseName")
+    def default_database_name(self) -> pulumi.Output[Optional[str]]:
+        """
+        The database Neon created along with the project.
+        """
+        return pulumi.get(self, "default_database_name")
+
+    @property
+    @pulumi.getter(name="defaultEndpointHost")
+    def default_endpoint_host(self) -> pulumi.Output[Optional[str]]:
+        """
+        The hostname of the read-write endpoint on the default branch.
+        """
+        return pulumi.get(self, "default_endpoint_host")
+
+    @property
+    @pulumi.getter(name="defaultEndpointSettings")
+    def default_endpoint_settings(self) -> pulumi.Output[Optional['outputs.DefaultEndpointSettings']]:
+        """
+        The compute settings applied to endpoints created in the project.
+        """
+        return pulumi.get(self, "default_endpoint_settings")
+
+    @property
+    @pulumi.getter(name="defaultRoleName")
+    def default_role_name(self) -> pulumi.Output[Optional[str]]:
+        """
+        The role Neon created along with the project. It owns the default database.
+        """
+        return pulumi.get(self, "default_role_name")
+
+    @property
+    @pulumi.getter(name="historyRetentionSeconds")
+    def history_retention_seconds(self) -> pulumi.Output[Optional[int]]:
+        """
+        How long, in seconds, Neon keeps the history that branches can be created from.
+        """
+        return pulumi.get(self, "history_retention_seconds")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        """
+        The name of the project.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter(name="orgId")
+    def org_id(self) -> pulumi.Output[Optional[str]]:
+        """
+        The organization that owns the project. Defaults to the provider's orgId. Changing it replaces the project.
+        """
+        return pulumi.get(self, "org_id")
+
+    @property
+    @pulumi.getter(name="pgVersion")
+    def pg_version(self) -> pulumi.Output[Optional[int]]:
+        """
+        The major Postgres version. Changing it replaces the project.
+        """
+        return pulumi.get(self, "pg_version")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[str]:
+        """
+        The ID Neon assigned to the project.
+        """
+        return pulumi.get(self, "project_id")
+
+    @property
+    @pulumi.getter
+    def provisioner(self) -> pulumi.Output[Optional[str]]:
+        """
+        The compute provisioner, k8s-pod or k8s-neonvm. Changing it replaces the project.
+        """
+        return pulumi.get(self, "provisioner")
+
+    @property
+    @pulumi.getter(name="regionId")
+    def region_id(self) -> pulumi.Output[str]:
+        """
+        The region the project is hosted in, such as aws-us-east-2. Changing it replaces the project.
+        """
+        return pulumi.get(self, "region_id")
+
+    @property
+    @pulumi.getter(name="storePasswords")
+    def store_passwords(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether Neon stores role passwords so that they can be revealed later. Changing it replaces the project.
+        """
+        return pulumi.get(self, "store_passwords")
+
@@ -0,0 +1,244 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['ProviderArgs', 'Provider']
+
+@pulumi.input_type
+class ProviderArgs:
+    def __init__(__self__, *,
+                 api_key: Optional[pulumi.Input[str]] = None,
+                 api_url: Optional[pulumi.Input[str]] = None,
+                 max_retries: Optional[pulumi.Input[int]] = None,
+                 org_id: Optional[pulumi.Input[str]] = None,
+                 request_timeout_seconds: Optional[pulumi.Input[int]] = None,
+                 retry_timeout_seconds: Optional[pulumi.Input[int]] = None,
+                 user_agent_suffix: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a Provider resource.
+        :param pulumi.Input[str] api_key: The Neon API key. Defaults to the NEON_API_KEY environment variable.
+        :param pulumi.Input[str] api_url: The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+        :param pulumi.Input[int] max_retries: How many times a failed request to the Neon API is retried. Zero disables retries.
+        :param pulumi.Input[str] org_id: The organization that projects are created in when they do not set their own orgId.
+        :param pulumi.Input[int] request_timeout_seconds: How long a single request to the Neon API may take.
+        :param pulumi.Input[int] retry_timeout_seconds: How long a request keeps retrying while Neon reports it as locked, rate limited or unavailable.
+        :param pulumi.Input[str] user_agent_suffix: Text appended to the User-Agent header of every request.
+        """
+        if api_key is not None:
+            pulumi.set(__self__, "api_key", api_key)
+        if api_url is not None:
+            pulumi.set(__self__, "api_url", api_url)
+        if max_retries is not None:
+            pulumi.set(__self__, "max_retries", max_retries)
+        if org_id is not None:
+            pulumi.set(__self__, "org_id", org_id)
+        if request_timeout_seconds is not None:
+            pulumi.set(__self__, "request_timeout_seconds", request_timeout_seconds)
+        if retry_timeout_seconds is not None:
+            pulumi.set(__self__, "retry_timeout_seconds", retry_timeout_seconds)
+        if user_agent_suffix is not None:
+            pulumi.set(__self__, "user_agent_suffix", user_agent_suffix)
+
+    @property
+    @pulumi.getter(name="apiKey")
+    def api_key(self) -> Optional[pulumi.Input[str]]:
+        """
+        The Neon API key. Defaults to the NEON_API_KEY environment variable.
+        """
+        return pulumi.get(self, "api_key")
+
+    @api_key.setter
+    def api_key(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "api_key", value)
+
+    @property
+    @pulumi.getter(name="apiUrl")
+    def api_url(self) -> Optional[pulumi.Input[str]]:
+        """
+        The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+        """
+        return pulumi.get(self, "api_url")
+
+    @api_url.setter
+    def api_url(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "api_url", value)
+
+    @property
+    @pulumi.getter(name="maxRetries")
+    def max_retries(self) -> Optional[pulumi.Input[int]]:
+        """
+        How many times a failed request to the Neon API is retried. Zero disables retries.
+        """
+        return pulumi.get(self, "max_retries")
+
+    @max_retries.setter
+    def max_retries(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "max_retries", value)
+
+    @property
+    @pulumi.getter(name="orgId")
+    def org_id(self) -> Optional[pulumi.Input[str]]:
+        """
+        The organization that projects are created in when they do not set their own orgId.
+        """
+        return pulumi.get(self, "org_id")
+
+    @org_id.setter
+    def org_id(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "org_id", value)
+
+    @property
+    @pulumi.getter(name="requestTimeoutSeconds")
+    def request_timeout_seconds(self) -> Optional[pulumi.Input[int]]:
+        """
+        How long a single request to the Neon API may take.
+        """
+        return pulumi.get(self, "request_timeout_seconds")
+
+    @request_timeout_seconds.setter
+    def request_timeout_seconds(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "request_timeout_seconds", value)
+
+    @property
+    @pulumi.getter(name="retryTimeoutSeconds")
+    def retry_timeout_seconds(self) -> Optional[pulumi.Input[int]]:
+        """
+        How long a request keeps retrying while Neon reports it as locked, rate limited or unavailable.
+        """
+        return pulumi.get(self, "retry_timeout_seconds")
+
+    @retry_timeout_seconds.setter
+    def retry_timeout_seconds(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "retry_timeout_seconds", value)
+
+    @property
+    @pulumi.getter(name="userAgentSuffix")
+    def user_agent_suffix(self) -> Optional[pulumi.Input[str]]:
+        """
+        Text appended to the User-Agent header of every request.
+        """
+        return pulumi.get(self, "user_agent_suffix")
+
+    @user_agent_suffix.setter
+    def user_agent_suffix(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "user_agent_suffix", value)
+
+
+class Provider(pulumi.ProviderResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 api_key: Optional[pulumi.Input[str]] = None,
+                 api_url: Optional[pulumi.Input[str]] = None,
+                 max_retries: Optional[pulumi.Input[int]] = None,
+                 org_id: Optional[pulumi.Input[str]] = None,
+                 request_timeout_seconds: Optional[pulumi.Input[int]] = None,
+                 retry_timeout_seconds: Optional[pulumi.Input[int]] = None,
+                 user_agent_suffix: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        Create a Neon resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] api_key: The Neon API key. Defaults to the NEON_API_KEY environment variable.
+        :param pulumi.Input[str] api_url: The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+        :param pulumi.Input[int] max_retries: How many times a failed request to the Neon API is retried. Zero disables retries.
+        :param pulumi.Input[str] org_id: The organization that projects are created in when they do not set their own orgId.
+        :param pulumi.Input[int] request_timeout_seconds: How long a single request to the Neon API may take.
+        :param pulumi.Input[int] retry_timeout_seconds: How long a request keeps retrying while Neon reports it as locked, rate limited or unavailable.
+        :param pulumi.Input[str] user_agent_suffix: Text appended to the User-Agent header of every request.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[ProviderArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Create a Neon resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param ProviderArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(ProviderArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 api_key: Optional[pulumi.Input[str]] = None,
+                 api_url: Optional[pulumi.Input[str]] = None,
+                 max_retries: Optional[pulumi.Input[int]] = None,
+                 org_id: Optional[pulumi.Input[str]] = None,
+                 request_timeout_seconds: Optional[pulumi.Input[int]] = None,
+                 retry_timeout_seconds: Optional[pulumi.Input[int]] = None,
+                 user_agent_suffix: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = ProviderArgs.__new__(ProviderArgs)
+
+            __props__.__dict__["api_key"] = None if api_key is None else pulumi.Output.secret(api_key)
+            __props__.__dict__["api_url"] = api_url
+            __props__.__dict__["max_retries"] = pulumi.Output.from_input(max_retries).apply(pulumi.runtime.to_json) if max_retries is not None else None
+            __props__.__dict__["org_id"] = org_id
+            __props__.__dict__["request_timeout_seconds"] = pulumi.Output.from_input(request_timeout_seconds).apply(pulumi.runtime.to_json) if request_timeout_seconds is not None else None
+            __props__.__dict__["retry_timeout_seconds"] = pulumi.Output.from_input(retry_timeout_seconds).apply(pulumi.runtime.to_json) if retry_timeout_seconds is not None else None
+            __props__.__dict__["user_agent_suffix"] = user_agent_suffix
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["apiKey"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(Provider, __self__).__init__(
+            'neon',
+            resource_name,
+            __props__,
+            opts)
+
+    @property
+    @pulumi.getter(name="apiKey")
+    def api_key(self) -> pulumi.Output[Optional[str]]:
+        """
+        The Neon API key. Defaults to the NEON_API_KEY environment variable.
+        """
+        return pulumi.get(self, "api_key")
+
+    @property
+    @pulumi.getter(name="apiUrl")
+    def api_url(self) -> pulumi.Output[Optional[str]]:
+        """
+        The base URL of the Neon API. Defaults to the NEON_API_URL environment variable, then https://console.neon.tech/api/v2.
+        """
+        return pulumi.get(self, "api_url")
+
+    @property
+    @pulumi.getter(name="orgId")
+    def org_id(self) -> pulumi.Output[Optional[str]]:
+        """
+        The organization that projects are created in when they do not set their own orgId.
+        """
+        return pulumi.get(self, "org_id")
+
+    @property
+    @pulumi.getter(name="userAgentSuffix")
+    def user_agent_suffix(self) -> pulumi.Output[Optional[str]]:
+        """
+        Text appended to the User-Agent header of every request.
+        """
+        return pulumi.get(self, "user_agent_suffix")
+
@@ -0,0 +1,231 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['RoleArgs', 'Role']
+
+@pulumi.input_type
+class RoleArgs:
+    def __init__(__self__, *,
+                 branch_id: pulumi.Input[str],
+                 name: pulumi.Input[str],
+                 project_id: pulumi.Input[str],
+                 password_version: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a Role resource.
+        :param pulumi.Input[str] branch_id: The ID of the branch the role is on. Changing it replaces the role.
+        :param pulumi.Input[str] name: The name of the role. Changing it replaces the role.
+        :param pulumi.Input[str] project_id: The ID of the project the role belongs to. Changing it replaces the role.
+        :param pulumi.Input[str] password_version: An arbitrary value. Changing it resets the role's password.
+        """
+        pulumi.set(__self__, "branch_id", branch_id)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "project_id", project_id)
+        if password_version is not None:
+            pulumi.set(__self__, "password_version", password_version)
+
+    @property
+    @pulumi.getter(name="branchId")
+    def branch_id(self) -> pulumi.Input[str]:
+        """
+        The ID of the branch the role is on. Changing it replaces the role.
+        """
+        return pulumi.get(self, "branch_id")
+
+    @branch_id.setter
+    def branch_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "branch_id", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[str]:
+        """
+        The name of the role. Changing it replaces the role.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[str]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Input[str]:
+        """
+        The ID of the project the role belongs to. Changing it replaces the role.
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: pulumi.Input[str]):
+        pulumi.set(self, "project_id", value)
+
+    @property
+    @pulumi.getter(name="passwordVersion")
+    def password_version(self) -> Optional[pulumi.Input[str]]:
+        """
+        An arbitrary value. Changing it resets the role's password.
+        """
+        return pulumi.get(self, "password_version")
+
+    @password_version.setter
+    def password_version(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "password_version", value)
+
+
+class Role(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 branch_id: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 password_version: Optional[pulumi.Input[str]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        A Postgres role on a Neon branch. Import it with an ID of the form projectId/branchId/roleName.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] branch_id: The ID of the branch the role is on. Changing it replaces the role.
+        :param pulumi.Input[str] name: The name of the role. Changing it replaces the role.
+        :param pulumi.Input[str] password_version: An arbitrary value. Changing it resets the role's password.
+        :param pulumi.Input[str] project_id: The ID of the project the role belongs to. Changing it replaces the role.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: RoleArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A Postgres role on a Neon branch. Import it with an ID of the form projectId/branchId/roleName.
+
+        :param str resource_name: The name of the resource.
+        :param RoleArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(RoleArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 branch_id: Optional[pulumi.Input[str]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 password_version: Optional[pulumi.Input[str]] = None,
+                 project_id: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = RoleArgs.__new__(RoleArgs)
+
+            if branch_id is None and not opts.urn:
+                raise TypeError("Missing required property 'branch_id'")
+            __props__.__dict__["branch_id"] = branch_id
+            if name is None and not opts.urn:
+                raise TypeError("Missing required property 'name'")
+            __props__.__dict__["name"] = name
+            __props__.__dict__["password_version"] = password_version
+            if project_id is None and not opts.urn:
+                raise TypeError("Missing required property 'project_id'")
+            __props__.__dict__["project_id"] = project_id
+            __props__.__dict__["created_at"] = None
+            __props__.__dict__["password"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["password"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(Role, __self__).__init__(
+            'neon:index:Role',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Role':
+        """
+        Get an existing Role resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = RoleArgs.__new__(RoleArgs)
+
+        __props__.__dict__["branch_id"] = None
+        __props__.__dict__["created_at"] = None
+        __props__.__dict__["name"] = None
+        __props__.__dict__["password"] = None
+        __props__.__dict__["password_version"] = None
+        __props__.__dict__["project_id"] = None
+        return Role(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="branchId")
+    def branch_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the branch the role is on. Changing it replaces the role.
+        """
+        return pulumi.get(self, "branch_id")
+
+    @property
+    @pulumi.getter(name="createdAt")
+    def created_at(self) -> pulumi.Output[str]:
+        """
+        When the role was created, in RFC 3339 format.
+        """
+        return pulumi.get(self, "created_at")
+
+    @property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[str]:
+        """
+        The name of the role. Changing it replaces the role.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def password(self) -> pulumi.Output[Optional[str]]:
+        """
+        The role's current password, as generated by Neon.
+        """
+        return pulumi.get(self, "password")
+
+    @property
+    @pulumi.getter(name="passwordVersion")
+    def password_version(self) -> pulumi.Output[Optional[str]]:
+        """
+        An arbitrary value. Changing it resets the role's password.
+        """
+        return pulumi.get(self, "password_version")
+
+    @property
+    @pulumi.getter(name="projectId")
+    def project_id(self) -> pulumi.Output[str]:
+        """
+        The ID of the project the role belongs to. Changing it replaces the role.
+        """
+        return pulumi.get(self, "project_id")
+
@@ -1,5 +1,5 @@
 # coding=utf-8
-# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** WARNING: this file was generated by pulumi-gen-neon. ***
 # *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import errno
@@ -14,24 +14,31 @@ def readme():
         with open('README.md', encoding='utf-8') as f:
             return f.read()
     except FileNotFoundError:
-        return "xyz Pulumi Package - Development Version"
+        return "neon Pulumi Package - Development Version"
 
 
-setup(name='pulumi_xyz',
-      python_requires='>=3.7',
+setup(name='pulumi_neon',
+      python_requires='>=3.8',
       version=VERSION,
+      description="A Pulumi provider for managing Neon serverless Postgres projects, branches, endpoints, databases and roles.",
       long_description=readme(),
       long_description_content_type='text/markdown',
+      keywords='pulumi neon postgres category/database kind/native',
+      url='https://github.com/DonsWayo/pulumi-neon',
+      project_urls={
+          'Repository': 'https://github.com/DonsWayo/pulumi-neon'
+      },
+      license='Apache-2.0',
       packages=find_packages(),
       package_data={
-          'pulumi_xyz': [
+          'pulumi_neon': [
               'py.typed',
               'pulumi-plugin.json',
           ]
       },
       install_requires=[
           'parver>=0.2.1',
-          'pulumi',
+          'pulumi>=3.0.0,<4.0.0',
           'semver>=2.8.1'
       ],
       zip_safe=False)
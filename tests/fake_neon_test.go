@@ -0,0 +1,1295 @@
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testAPIKey = "test-api-key"
+
+// fakeNeon is an in-memory implementation of the parts of the Neon v2 API that the
+// provider uses. Projects, branches, endpoints, databases, roles and operations are kept
+// in memory and change the way Neon changes them, so whole resource lifecycles can run
+// without network access. Faults can be injected to exercise retries and timeouts.
+type fakeNeon struct {
+	server *httptest.Server
+
+	mu       sync.Mutex
+	nextId   int
+	projects map[string]*fakeProject
+	faults   []fault
+	// requests records the method and path of every request, in order.
+	requests []string
+	// operationPolls is how many times an operation is reported as running before it
+	// finishes. Zero starts every operation finished.
+	operationPolls int
+}
+
+// fault describes a failure injected into the next request that matches it. An empty
+// Method or Path matches any. Delay is waited out before responding, and a zero Status
+// lets the request through once the delay has passed.
+type fault struct {
+	Method     string
+	Path       string
+	Status     int
+	RetryAfter string
+	Delay      time.Duration
+}
+
+func (f fault) matches(r *http.Request) bool {
+	return (f.Method == "" || f.Method == r.Method) && (f.Path == "" || f.Path == r.URL.Path)
+}
+
+type fakeProject struct {
+	Id                      string                 `json:"id"`
+	Name                    string                 `json:"name"`
+	RegionId                string                 `json:"region_id"`
+	OrgId                   string                 `json:"org_id,omitempty"`
+	PgVersion               int                    `json:"pg_version"`
+	Provisioner             string                 `json:"provisioner"`
+	StorePasswords          bool                   `json:"store_passwords"`
+	HistoryRetentionSeconds int                    `json:"history_retention_seconds"`
+	DefaultEndpointSettings map[string]interface{} `json:"default_endpoint_settings,omitempty"`
+	CreatedAt               string                 `json:"created_at"`
+
+	branches   map[string]*fakeBranch
+	endpoints  map[string]*fakeEndpoint
+	operations map[string]*fakeOperation
+}
+
+type fakeBranch struct {
+	Id              string  `json:"id"`
+	Name            string  `json:"name"`
+	ProjectId       string  `json:"project_id"`
+	Default         bool    `json:"default"`
+	ParentId        *string `json:"parent_id,omitempty"`
+	ParentLsn       *string `json:"parent_lsn,omitempty"`
+	ParentTimestamp *string `json:"parent_timestamp,omitempty"`
+	ExpiresAt       *string `json:"expires_at,omitempty"`
+	CreatedAt       string  `json:"created_at"`
+
+	databases map[string]*fakeDatabase
+	roles     map[string]*fakeRole
+}
+
+type fakeEndpoint struct {
+	Id                    string  `json:"id"`
+	Host                  string  `json:"host"`
+	ProjectId             string  `json:"project_id"`
+	BranchId              string  `json:"branch_id"`
+	Type                  string  `json:"type"`
+	AutoscalingLimitMinCu float64 `json:"autoscaling_limit_min_cu"`
+	AutoscalingLimitMaxCu float64 `json:"autoscaling_limit_max_cu"`
+	SuspendTimeoutSeconds int     `json:"suspend_timeout_seconds"`
+	PoolerEnabled         bool    `json:"pooler_enabled"`
+	PoolerMode            string  `json:"pooler_mode"`
+	RegionId              string  `json:"region_id"`
+	Provisioner           string  `json:"provisioner"`
+	CurrentState          string  `json:"current_state"`
+	Settings              struct {
+		PgSettings map[string]string `json:"pg_settings,omitempty"`
+	} `json:"settings"`
+	CreatedAt string `json:"created_at"`
+}
+
+type fakeDatabase struct {
+	Id        int64  `json:"id"`
+	Name      string `json:"name"`
+	OwnerName string `json:"owner_name"`
+	BranchId  string `json:"branch_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+type fakeRole struct {
+	Name      string `json:"name"`
+	BranchId  string `json:"branch_id"`
+	Password  string `json:"password,omitempty"`
+	Protected bool   `json:"protected"`
+	CreatedAt string `json:"created_at"`
+}
+
+type fakeOperation struct {
+	Id         string `json:"id"`
+	ProjectId  string `json:"project_id"`
+	BranchId   string `json:"branch_id,omitempty"`
+	EndpointId string `json:"endpoint_id,omitempty"`
+	Action     string `json:"action"`
+	Status     string `json:"status"`
+
+	polls int
+}
+
+// errorBody is returned by handlers to send a Neon error response.
+type errorBody struct {
+	status  int
+	message string
+}
+
+func notFound(format string, args ...interface{}) *errorBody {
+	return &errorBody{http.StatusNotFound, fmt.Sprintf(format, args...)}
+}
+
+// newFakeNeon starts a fake Neon API that is shut down when the test ends.
+func newFakeNeon(t *testing.T) *fakeNeon {
+	f := &fakeNeon{
+		projects: map[string]*fakeProject{},
+	}
+
+	mux := http.NewServeMux()
+	f.route(mux, "GET /regions", f.listRegions)
+
+	f.route(mux, "GET /projects", f.listProjects)
+	f.route(mux, "POST /projects", f.createProject)
+	f.route(mux, "GET /projects/{project}", f.getProject)
+	f.route(mux, "PATCH /projects/{project}", f.updateProject)
+	f.route(mux, "DELETE /projects/{project}", f.deleteProject)
+	f.route(mux, "GET /projects/{project}/connection_uri", f.getConnectionURI)
+	f.route(mux, "GET /projects/{project}/operations/{operation}", f.getOperation)
+
+	f.route(mux, "GET /projects/{project}/branches", f.listBranches)
+	f.route(mux, "POST /projects/{project}/branches", f.createBranch)
+	f.route(mux, "GET /projects/{project}/branches/{branch}", f.getBranch)
+	f.route(mux, "PATCH /projects/{project}/branches/{branch}", f.updateBranch)
+	f.route(mux, "DELETE /projects/{project}/branches/{branch}", f.deleteBranch)
+	f.route(mux, "POST /projects/{project}/branches/{branch}/restore", f.restoreBranch)
+	f.route(mux, "GET /projects/{project}/branches/{branch}/endpoints", f.listBranchEndpoints)
+
+	f.route(mux, "GET /projects/{project}/endpoints", f.listEndpoints)
+	f.route(mux, "POST /projects/{project}/endpoints", f.createEndpoint)
+	f.route(mux, "GET /projects/{project}/endpoints/{endpoint}", f.getEndpoint)
+	f.route(mux, "PATCH /projects/{project}/endpoints/{endpoint}", f.updateEndpoint)
+	f.route(mux, "DELETE /projects/{project}/endpoints/{endpoint}", f.deleteEndpoint)
+
+	f.route(mux, "GET /projects/{project}/branches/{branch}/databases", f.listDatabases)
+	f.route(mux, "POST /projects/{project}/branches/{branch}/databases", f.createDatabase)
+	f.route(mux, "GET /projects/{project}/branches/{branch}/databases/{database}", f.getDatabase)
+	f.route(mux, "PATCH /projects/{project}/branches/{branch}/databases/{database}", f.updateDatabase)
+	f.route(mux, "DELETE /projects/{project}/branches/{branch}/databases/{database}", f.deleteDatabase)
+
+	f.route(mux, "GET /projects/{project}/branches/{branch}/roles", f.listRoles)
+	f.route(mux, "POST /projects/{project}/branches/{branch}/roles", f.createRole)
+	f.route(mux, "GET /projects/{project}/branches/{branch}/roles/{role}", f.getRole)
+	f.route(mux, "DELETE /projects/{project}/branches/{branch}/roles/{role}", f.deleteRole)
+	f.route(mux, "GET /projects/{project}/branches/{branch}/roles/{role}/reveal_password", f.revealRolePassword)
+	f.route(mux, "POST /projects/{project}/branches/{branch}/roles/{role}/reset_password", f.resetRolePassword)
+
+	f.server = httptest.NewServer(f.middleware(mux))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+// URL is the base URL of the fake API.
+func (f *fakeNeon) URL() string {
+	return f.server.URL
+}
+
+// inject queues faults. Each one applies to the first later request that matches it.
+func (f *fakeNeon) inject(faults ...fault) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.faults = append(f.faults, faults...)
+}
+
+// count returns how many requests matched method and path.
+func (f *fakeNeon) count(method, path string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, request := range f.requests {
+		if request == method+" "+path {
+			n++
+		}
+	}
+	return n
+}
+
+// middleware authenticates requests, records them and applies injected faults.
+func (f *fakeNeon) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testAPIKey {
+			writeError(w, &errorBody{http.StatusUnauthorized, "authentication required"})
+			return
+		}
+
+		f.mu.Lock()
+		f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+		var injected *fault
+		for i, fault := range f.faults {
+			if fault.matches(r) {
+				injected = &fault
+				f.faults = append(f.faults[:i], f.faults[i+1:]...)
+				break
+			}
+		}
+		f.mu.Unlock()
+
+		if injected != nil {
+			if injected.Delay > 0 {
+				select {
+				case <-r.Context().Done():
+					return
+				case <-time.After(injected.Delay):
+				}
+			}
+			if injected.Status != 0 {
+				if injected.RetryAfter != "" {
+					w.Header().Set("Retry-After", injected.RetryAfter)
+				}
+				writeError(w, &errorBody{injected.Status, http.StatusText(injected.Status)})
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// route registers a handler that runs with the fake's state locked. It returns the
+// response status and body, or an *errorBody.
+func (f *fakeNeon) route(mux *http.ServeMux, pattern string, handler func(r *http.Request) (int, interface{})) {
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		status, body := handler(r)
+		f.mu.Unlock()
+
+		if err, ok := body.(*errorBody); ok {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	})
+}
+
+func writeError(w http.ResponseWriter, err *errorBody) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(err.status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"code": "", "message": err.message})
+}
+
+func decode(r *http.Request, body interface{}) *errorBody {
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		return &errorBody{http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err)}
+	}
+	return nil
+}
+
+func (f *fakeNeon) id(prefix string) string {
+	f.nextId++
+	return fmt.Sprintf("%s-%d", prefix, f.nextId)
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// operation starts an operation on project. It is reported as running until it has been
+// polled operationPolls times.
+func (f *fakeNeon) operation(project *fakeProject, action, branchId, endpointId string) *fakeOperation {
+	op := &fakeOperation{
+		Id:         f.id("op"),
+		ProjectId:  project.Id,
+		BranchId:   branchId,
+		EndpointId: endpointId,
+		Action:     action,
+		Status:     "running",
+	}
+	if f.operationPolls == 0 {
+		op.Status = "finished"
+	}
+	project.operations[op.Id] = op
+	return op
+}
+
+// unfinishedOperations returns the IDs of operations that are still running.
+func (f *fakeNeon) unfinishedOperations() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var ids []string
+	for _, project := range f.projects {
+		for _, op := range project.operations {
+			if op.Status != "finished" {
+				ids = append(ids, op.Id)
+			}
+		}
+	}
+	return ids
+}
+
+func (f *fakeNeon) project(r *http.Request) (*fakeProject, *errorBody) {
+	project, ok := f.projects[r.PathValue("project")]
+	if !ok {
+		return nil, notFound("project %s not found", r.PathValue("project"))
+	}
+	return project, nil
+}
+
+func (f *fakeNeon) branch(r *http.Request) (*fakeProject, *fakeBranch, *errorBody) {
+	project, err := f.project(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	branch, ok := project.branches[r.PathValue("branch")]
+	if !ok {
+		return nil, nil, notFound("branch %s not found", r.PathValue("branch"))
+	}
+	return project, branch, nil
+}
+
+func (p *fakeProject) defaultBranch() *fakeBranch {
+	for _, branch := range p.branches {
+		if branch.Default {
+			return branch
+		}
+	}
+	return nil
+}
+
+func (p *fakeProject) branchEndpoints(branchId string) []*fakeEndpoint {
+	endpoints := []*fakeEndpoint{}
+	for _, endpoint := range p.endpoints {
+		if endpoint.BranchId == branchId {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	sortById(endpoints, func(e *fakeEndpoint) string { return e.Id })
+	return endpoints
+}
+
+func sortById[T any](items []T, id func(T) string) {
+	sort.Slice(items, func(i, j int) bool { return id(items[i]) < id(items[j]) })
+}
+
+func (f *fakeNeon) listRegions(r *http.Request) (int, interface{}) {
+	return http.StatusOK, map[string]interface{}{
+		"regions": []map[string]interface{}{
+			{"region_id": "aws-us-east-2", "name": "AWS US East 2 (Ohio)", "default": true},
+			{"region_id": "aws-eu-central-1", "name": "AWS Europe Central 1 (Frankfurt)"},
+		},
+	}
+}
+
+// seedProject creates a project as if through the API, for tests that need one to exist
+// before the resource under test.
+func (f *fakeNeon) seedProject(name string) *fakeProject {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	project, _, _ := f.newProject(fakeProject{Name: name, RegionId: "aws-us-east-2", StorePasswords: true})
+	return project
+}
+
+// seedBranch creates a branch of the project's default branch.
+func (f *fakeNeon) seedBranch(project *fakeProject, name string) *fakeBranch {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	branch, _ := f.newBranch(project, name, project.defaultBranch().Id, nil, nil)
+	return branch
+}
+
+// seedRole creates a role on a branch.
+func (f *fakeNeon) seedRole(branch *fakeBranch, name string) *fakeRole {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	role := &fakeRole{Name: name, BranchId: branch.Id, Password: f.id("pw"), CreatedAt: now()}
+	branch.roles[name] = role
+	return role
+}
+
+// newProject creates a project with the default branch, read-write endpoint, owner role
+// and database Neon creates along with every project.
+func (f *fakeNeon) newProject(args fakeProject) (*fakeProject, *fakeEndpoint, []*fakeOperation) {
+	project := &args
+	project.Id = f.id("p")
+	project.CreatedAt = now()
+	if project.PgVersion == 0 {
+		project.PgVersion = 16
+	}
+	if project.Provisioner == "" {
+		project.Provisioner = "k8s-neonvm"
+	}
+	if project.HistoryRetentionSeconds == 0 {
+		project.HistoryRetentionSeconds = 86400
+	}
+	project.branches = map[string]*fakeBranch{}
+	project.endpoints = map[string]*fakeEndpoint{}
+	project.operations = map[string]*fakeOperation{}
+	f.projects[project.Id] = project
+
+	branch := &fakeBranch{
+		Id:        f.id("br"),
+		Name:      "main",
+		ProjectId: project.Id,
+		Default:   true,
+		CreatedAt: now(),
+		databases: map[string]*fakeDatabase{},
+		roles:     map[string]*fakeRole{},
+	}
+	project.branches[branch.Id] = branch
+	branch.roles["neondb_owner"] = &fakeRole{Name: "neondb_owner", BranchId: branch.Id, Password: f.id("pw"), CreatedAt: now()}
+	f.nextId++
+	branch.databases["neondb"] = &fakeDatabase{Id: int64(f.nextId), Name: "neondb", OwnerName: "neondb_owner", BranchId: branch.Id, CreatedAt: now()}
+	endpoint := f.newEndpoint(project, branch.Id, "read_write")
+
+	operations := []*fakeOperation{
+		f.operation(project, "create_timeline", branch.Id, ""),
+		f.operation(project, "start_compute", branch.Id, endpoint.Id),
+	}
+	return project, endpoint, operations
+}
+
+func (f *fakeNeon) listProjects(r *http.Request) (int, interface{}) {
+	query := r.URL.Query()
+	limit := 10
+	if l, err := strconv.Atoi(query.Get("limit")); err == nil && l > 0 {
+		limit = l
+	}
+
+	projects := []*fakeProject{}
+	for _, project := range f.projects {
+		if orgId := query.Get("org_id"); orgId != "" && project.OrgId != orgId {
+			continue
+		}
+		if cursor := query.Get("cursor"); cursor != "" && project.Id <= cursor {
+			continue
+		}
+		projects = append(projects, project)
+	}
+	sortById(projects, func(p *fakeProject) string { return p.Id })
+	if len(projects) > limit {
+		projects = projects[:limit]
+	}
+
+	cursor := ""
+	if len(projects) > 0 {
+		cursor = projects[len(projects)-1].Id
+	}
+	return http.StatusOK, map[string]interface{}{
+		"projects":   projects,
+		"pagination": map[string]interface{}{"cursor": cursor},
+	}
+}
+
+func (f *fakeNeon) createProject(r *http.Request) (int, interface{}) {
+	var body struct {
+		Project struct {
+			fakeProject
+			StorePasswords *bool `json:"store_passwords"`
+		} `json:"project"`
+	}
+	if err := decode(r, &body); err != nil {
+		return 0, err
+	}
+	args := body.Project.fakeProject
+	args.StorePasswords = body.Project.StorePasswords == nil || *body.Project.StorePasswords
+	if args.Name == "" || args.RegionId == "" {
+		return 0, &errorBody{http.StatusBadRequest, "name and region_id are required"}
+	}
+
+	project, endpoint, operations := f.newProject(args)
+	branch := project.defaultBranch()
+	role, database := branch.roles["neondb_owner"], branch.databases["neondb"]
+	return http.StatusCreated, map[string]interface{}{
+		"project":    project,
+		"branch":     branch,
+		"endpoints":  []*fakeEndpoint{endpoint},
+		"roles":      []*fakeRole{role},
+		"databases":  []*fakeDatabase{database},
+		"operations": operations,
+		"connection_uris": []map[string]interface{}{
+			{"connection_uri": connectionURI(endpoint.Host, role, database.Name)},
+		},
+	}
+}
+
+func (f *fakeNeon) getProject(r *http.Request) (int, interface{}) {
+	project, err := f.project(r)
+	if err != nil {
+		return 0, err
+	}
+	return http.StatusOK, map[string]interface{}{"project": project}
+}
+
+func (f *fakeNeon) updateProject(r *http.Request) (int, interface{}) {
+	project, err := f.project(r)
+	if err != nil {
+		return 0, err
+	}
+	var body struct {
+		Project struct {
+			Name                    *string                `json:"name"`
+			HistoryRetentionSeconds *int                   `json:"history_retention_seconds"`
+			DefaultEndpointSettings map[string]interface{} `json:"default_endpoint_settings"`
+		} `json:"project"`
+	}
+	if err := decode(r, &body); err != nil {
+		return 0, err
+	}
+	if body.Project.Name != nil {
+		project.Name = *body.Project.Name
+	}
+	if body.Project.HistoryRetentionSeconds != nil {
+		project.HistoryRetentionSeconds = *body.Project.HistoryRetentionSeconds
+	}
+	if body.Project.DefaultEndpointSettings != nil {
+		project.DefaultEndpointSettings = body.Project.DefaultEndpointSettings
+	}
+	return http.StatusOK, map[string]interface{}{
+		"project":    project,
+		"operations": []*fakeOperation{},
+	}
+}
+
+func (f *fakeNeon) deleteProject(r *http.Request) (int, interface{}) {
+	project, err := f.project(r)
+	if err != nil {
+		return 0, err
+	}
+	delete(f.projects, project.Id)
+	return http.StatusOK, map[string]interface{}{"project": project}
+}
+
+func connectionURI(host string, role *fakeRole, database string) string {
+	return fmt.Sprintf("postgresql://%s:%s@%s/%s?sslmode=require", role.Name, role.Password, host, database)
+}
+
+func (f *fakeNeon) getConnectionURI(r *http.Request) (int, interface{}) {
+	project, err := f.project(r)
+	if err != nil {
+		return 0, err
+	}
+	query := r.URL.Query()
+	branchId := query.Get("branch_id")
+	if branchId == "" {
+		branchId = project.defaultBranch().Id
+	}
+	branch, ok := project.branches[branchId]
+	if !ok {
+		return 0, notFound("branch %s not found", branchId)
+	}
+
+	var endpoint *fakeEndpoint
+	if endpointId := query.Get("endpoint_id"); endpointId != "" {
+		endpoint = project.endpoints[endpointId]
+	} else {
+		for _, e := range project.branchEndpoints(branch.Id) {
+			if e.Type == "read_write" {
+				endpoint = e
+			}
+		}
+	}
+	if endpoint == nil || endpoint.BranchId != branch.Id {
+		return 0, notFound("endpoint not found on branch %s", branch.Id)
+	}
+	role, ok := branch.roles[query.Get("role_name")]
+	if !ok {
+		return 0, notFound("role %q not found", query.Get("role_name"))
+	}
+	if _, ok := branch.databases[query.Get("database_name")]; !ok {
+		return 0, notFound("database %q not found", query.Get("database_name"))
+	}
+	if !project.StorePasswords {
+		return 0, &errorBody{http.StatusBadRequest, "project does not store passwords"}
+	}
+
+	host := endpoint.Host
+	if query.Get("pooled") == "true" {
+		host = strings.Replace(host, endpoint.Id, endpoint.Id+"-pooler", 1)
+	}
+	return http.StatusOK, map[string]interface{}{"uri": connectionURI(host, role, query.Get("database_name"))}
+}
+
+func (f *fakeNeon) getOperation(r *http.Request) (int, interface{}) {
+	project, err := f.project(r)
+	if err != nil {
+		return 0, err
+	}
+	op, ok := project.operations[r.PathValue("operation")]
+	if !ok {
+		return 0, notFound("operation %s not found", r.PathValue("operation"))
+	}
+	op.polls++
+	if op.polls >= f.operationPolls {
+		op.Status = "finished"
+	}
+	return http.StatusOK, map[string]interface{}{"operation": op}
+}
+
+func (f *fakeNeon) listBranches(r *http.Request) (int, interface{}) {
+	project, err := f.project(r)
+	if err != nil {
+		return 0, err
+	}
+	branches := []*fakeBranch{}
+	for _, branch := range project.branches {
+		branches = append(branches, branch)
+	}
+	sortById(branches, func(b *fakeBranch) string { return b.Id })
+	return http.StatusOK, map[string]interface{}{"branches": branches}
+}
+
+// newBranch branches from parentId, copying its roles and databases as Neon does.
+func (f *fakeNeon) newBranch(project *fakeProject, name, parentId string, parentLsn, parentTimestamp *string) (*fakeBranch, *errorBody) {
+	for _, branch := range project.branches {
+		if branch.Name == name {
+			return nil, &errorBody{http.StatusConflict, fmt.Sprintf("branch %q already exists", name)}
+		}
+	}
+	parent, ok := project.branches[parentId]
+	if !ok {
+		return nil, notFound("parent branch %s not found", parentId)
+	}
+
+	branch := &fakeBranch{
+		Id:              f.id("br"),
+		Name:            name,
+		ProjectId:       project.Id,
+		ParentId:        &parent.Id,
+		ParentLsn:       parentLsn,
+		ParentTimestamp: parentTimestamp,
+		CreatedAt:       now(),
+		databases:       map[string]*fakeDatabase{},
+		roles:           map[string]*fakeRole{},
+	}
+	for name, role := range parent.roles {
+		copied := *role
+		copied.BranchId = branch.Id
+		branch.roles[name] = &copied
+	}
+	for name, database := range parent.databases {
+		copied := *database
+		copied.BranchId = branch.Id
+		branch.databases[name] = &copied
+	}
+	project.branches[branch.Id] = branch
+	return branch, nil
+}
+
+func (f *fakeNeon) createBranch(r *http.Request) (int, interface{}) {
+	project, err := f.project(r)
+	if err != nil {
+		return 0, err
+	}
+	var body struct {
+		Branch struct {
+			Name            string  `json:"name"`
+			ParentId        string  `json:"parent_id"`
+			ParentLsn       *string `json:"parent_lsn"`
+			ParentTimestamp *string `json:"parent_timestamp"`
+			ExpiresAt       *string `json:"expires_at"`
+		} `json:"branch"`
+		Endpoints []fakeEndpoint `json:"endpoints"`
+	}
+	if err := decode(r, &body); err != nil {
+		return 0, err
+	}
+	if err := validExpiration(body.Branch.ExpiresAt); err != nil {
+		return 0, err
+	}
+	parentId := body.Branch.ParentId
+	if parentId == "" {
+		parentId = project.defaultBranch().Id
+	}
+
+	branch, err := f.newBranch(project, body.Branch.Name, parentId, body.Branch.ParentLsn, body.Branch.ParentTimestamp)
+	if err != nil {
+		return 0, err
+	}
+	branch.ExpiresAt = body.Branch.ExpiresAt
+	operations := []*fakeOperation{f.operation(project, "create_branch", branch.Id, "")}
+	endpoints := []*fakeEndpoint{}
+	for _, options := range body.Endpoints {
+		endpoint := f.newEndpoint(project, branch.Id, options.Type)
+		applyCompute(endpoint, options.AutoscalingLimitMinCu, options.AutoscalingLimitMaxCu, options.SuspendTimeoutSeconds)
+		endpoints = append(endpoints, endpoint)
+		operations = append(operations, f.operation(project, "start_compute", branch.Id, endpoint.Id))
+	}
+	return http.StatusCreated, map[string]interface{}{
+		"branch":     branch,
+		"endpoints":  endpoints,
+		"operations": operations,
+	}
+}
+
+func (f *fakeNeon) getBranch(r *http.Request) (int, interface{}) {
+	_, branch, err := f.branch(r)
+	if err != nil {
+		return 0, err
+	}
+	return http.StatusOK, map[string]interface{}{"branch": branch}
+}
+
+func (f *fakeNeon) updateBranch(r *http.Request) (int, interface{}) {
+	project, branch, err := f.branch(r)
+	if err != nil {
+		return 0, err
+	}
+	var body struct {
+		Branch struct {
+			Name string `json:"name"`
+			// ExpiresAt is left unchanged when it is missing and removed when it is null.
+			ExpiresAt json.RawMessage `json:"expires_at"`
+		} `json:"branch"`
+	}
+	if err := decode(r, &body); err != nil {
+		return 0, err
+	}
+	expiresAt := branch.ExpiresAt
+	if body.Branch.ExpiresAt != nil {
+		expiresAt = nil
+		if err := json.Unmarshal(body.Branch.ExpiresAt, &expiresAt); err != nil {
+			return 0, &errorBody{http.StatusBadRequest, err.Error()}
+		}
+		if err := validExpiration(expiresAt); err != nil {
+			return 0, err
+		}
+	}
+	for _, other := range project.branches {
+		if other.Name == body.Branch.Name && other.Id != branch.Id {
+			return 0, &errorBody{http.StatusConflict, fmt.Sprintf("branch %q already exists", body.Branch.Name)}
+		}
+	}
+	branch.Name = body.Branch.Name
+	branch.ExpiresAt = expiresAt
+	return http.StatusOK, map[string]interface{}{
+		"branch":     branch,
+		"operations": []*fakeOperation{},
+	}
+}
+
+// validExpiration rejects expiration times that are malformed or already past, as Neon
+// does.
+func validExpiration(expiresAt *string) *errorBody {
+	if expiresAt == nil {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, *expiresAt)
+	if err != nil || !t.After(time.Now()) {
+		return &errorBody{http.StatusBadRequest, fmt.Sprintf("expires_at %q must be a future RFC 3339 timestamp", *expiresAt)}
+	}
+	return nil
+}
+
+// deleteExpiredBranches deletes the branches whose expiration has passed, as Neon does in
+// the background.
+func (f *fakeNeon) deleteExpiredBranches() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, project := range f.projects {
+		for id, branch := range project.branches {
+			if branch.ExpiresAt == nil {
+				continue
+			}
+			if expiresAt, err := time.Parse(time.RFC3339, *branch.ExpiresAt); err != nil || expiresAt.After(time.Now()) {
+				continue
+			}
+			for _, endpoint := range project.branchEndpoints(id) {
+				delete(project.endpoints, endpoint.Id)
+			}
+			delete(project.branches, id)
+		}
+	}
+}
+
+func (f *fakeNeon) deleteBranch(r *http.Request) (int, interface{}) {
+	project, branch, err := f.branch(r)
+	if err != nil {
+		return 0, err
+	}
+	if branch.Default {
+		return 0, &errorBody{http.StatusUnprocessableEntity, "cannot delete the default branch"}
+	}
+	for _, other := range project.branches {
+		if other.ParentId != nil && *other.ParentId == branch.Id {
+			return 0, &errorBody{http.StatusUnprocessableEntity, fmt.Sprintf("branch %s has child branches", branch.Id)}
+		}
+	}
+
+	operations := []*fakeOperation{}
+	for _, endpoint := range project.branchEndpoints(branch.Id) {
+		delete(project.endpoints, endpoint.Id)
+		operations = append(operations, f.operation(project, "suspend_compute", branch.Id, endpoint.Id))
+	}
+	delete(project.branches, branch.Id)
+	operations = append(operations, f.operation(project, "delete_timeline", branch.Id, ""))
+	return http.StatusOK, map[string]interface{}{
+		"branch":     branch,
+		"operations": operations,
+	}
+}
+
+// restoreBranch replaces a branch's roles and databases with those of the source branch.
+// With preserve_under_name, the branch's previous data is kept as a new sibling branch.
+func (f *fakeNeon) restoreBranch(r *http.Request) (int, interface{}) {
+	project, branch, err := f.branch(r)
+	if err != nil {
+		return 0, err
+	}
+	var body struct {
+		SourceBranchId    string  `json:"source_branch_id"`
+		SourceLsn         *string `json:"source_lsn"`
+		SourceTimestamp   *string `json:"source_timestamp"`
+		PreserveUnderName *string `json:"preserve_under_name"`
+	}
+	if err := decode(r, &body); err != nil {
+		return 0, err
+	}
+	if body.SourceLsn != nil && body.SourceTimestamp != nil {
+		return 0, &errorBody{http.StatusBadRequest, "source_lsn and source_timestamp cannot both be set"}
+	}
+	source, ok := project.branches[body.SourceBranchId]
+	if !ok {
+		return 0, notFound("source branch %s not found", body.SourceBranchId)
+	}
+
+	if body.PreserveUnderName != nil {
+		preserved, err := f.newBranch(project, *body.PreserveUnderName, branch.Id, nil, nil)
+		if err != nil {
+			return 0, err
+		}
+		preserved.ParentId = branch.ParentId
+		preserved.ParentLsn = branch.ParentLsn
+		preserved.ParentTimestamp = branch.ParentTimestamp
+	}
+
+	branch.roles = map[string]*fakeRole{}
+	for name, role := range source.roles {
+		copied := *role
+		copied.BranchId = branch.Id
+		branch.roles[name] = &copied
+	}
+	branch.databases = map[string]*fakeDatabase{}
+	for name, database := range source.databases {
+		copied := *database
+		copied.BranchId = branch.Id
+		branch.databases[name] = &copied
+	}
+	if branch.ParentId != nil && *branch.ParentId == source.Id {
+		branch.ParentLsn = body.SourceLsn
+		branch.ParentTimestamp = body.SourceTimestamp
+	}
+
+	operations := []*fakeOperation{f.operation(project, "restore_timeline", branch.Id, "")}
+	for _, endpoint := range project.branchEndpoints(branch.Id) {
+		operations = append(operations, f.operation(project, "start_compute", branch.Id, endpoint.Id))
+	}
+	return http.StatusOK, map[string]interface{}{
+		"branch":     branch,
+		"operations": operations,
+	}
+}
+
+func (f *fakeNeon) listBranchEndpoints(r *http.Request) (int, interface{}) {
+	project, branch, err := f.branch(r)
+	if err != nil {
+		return 0, err
+	}
+	return http.StatusOK, map[string]interface{}{"endpoints": project.branchEndpoints(branch.Id)}
+}
+
+// newEndpoint starts an endpoint on a branch with the project's default compute settings.
+func (f *fakeNeon) newEndpoint(project *fakeProject, branchId, typ string) *fakeEndpoint {
+	id := f.id("ep")
+	endpoint := &fakeEndpoint{
+		Id:                    id,
+		Host:                  fmt.Sprintf("%s.%s.aws.neon.tech", id, strings.TrimPrefix(project.RegionId, "aws-")),
+		ProjectId:             project.Id,
+		BranchId:              branchId,
+		Type:                  typ,
+		AutoscalingLimitMinCu: 0.25,
+		AutoscalingLimitMaxCu: 0.25,
+		PoolerMode:            "transaction",
+		RegionId:              project.RegionId,
+		Provisioner:           project.Provisioner,
+		CurrentState:          "idle",
+		CreatedAt:             now(),
+	}
+	if s := project.DefaultEndpointSettings; s != nil {
+		min, _ := s["autoscaling_limit_min_cu"].(float64)
+		max, _ := s["autoscaling_limit_max_cu"].(float64)
+		suspend, _ := s["suspend_timeout_seconds"].(float64)
+		applyCompute(endpoint, min, max, int(suspend))
+	}
+	project.endpoints[id] = endpoint
+	return endpoint
+}
+
+// applyCompute sets the compute settings that are not zero.
+func applyCompute(endpoint *fakeEndpoint, min, max float64, suspend int) {
+	if min != 0 {
+		endpoint.AutoscalingLimitMinCu = min
+	}
+	if max != 0 {
+		endpoint.AutoscalingLimitMaxCu = max
+	}
+	if suspend != 0 {
+		endpoint.SuspendTimeoutSeconds = suspend
+	}
+}
+
+func (f *fakeNeon) listEndpoints(r *http.Request) (int, interface{}) {
+	project, err := f.project(r)
+	if err != nil {
+		return 0, err
+	}
+	endpoints := []*fakeEndpoint{}
+	for _, endpoint := range project.endpoints {
+		endpoints = append(endpoints, endpoint)
+	}
+	sortById(endpoints, func(e *fakeEndpoint) string { return e.Id })
+	return http.StatusOK, map[string]interface{}{"endpoints": endpoints}
+}
+
+// endpointBody is the body of an endpoint create or update.
+type endpointBody struct {
+	Endpoint struct {
+		BranchId              string                        `json:"branch_id"`
+		Type                  string                        `json:"type"`
+		RegionId              *string                       `json:"region_id"`
+		AutoscalingLimitMinCu float64                       `json:"autoscaling_limit_min_cu"`
+		AutoscalingLimitMaxCu float64                       `json:"autoscaling_limit_max_cu"`
+		SuspendTimeoutSeconds int                           `json:"suspend_timeout_seconds"`
+		PoolerEnabled         *bool                         `json:"pooler_enabled"`
+		PoolerMode            *string                       `json:"pooler_mode"`
+		Provisioner           *string                       `json:"provisioner"`
+		Settings              *map[string]map[string]string `json:"settings"`
+	} `json:"endpoint"`
+}
+
+// apply sets the endpoint's mutable settings from the body.
+func (b endpointBody) apply(endpoint *fakeEndpoint) *errorBody {
+	e := b.Endpoint
+	if e.AutoscalingLimitMaxCu != 0 && e.AutoscalingLimitMinCu > e.AutoscalingLimitMaxCu {
+		return &errorBody{http.StatusBadRequest, "autoscaling_limit_min_cu must not exceed autoscaling_limit_max_cu"}
+	}
+	applyCompute(endpoint, e.AutoscalingLimitMinCu, e.AutoscalingLimitMaxCu, e.SuspendTimeoutSeconds)
+	if e.PoolerEnabled != nil {
+		endpoint.PoolerEnabled = *e.PoolerEnabled
+	}
+	if e.PoolerMode != nil {
+		endpoint.PoolerMode = *e.PoolerMode
+	}
+	if e.Provisioner != nil {
+		endpoint.Provisioner = *e.Provisioner
+	}
+	if e.Settings != nil {
+		endpoint.Settings.PgSettings = (*e.Settings)["pg_settings"]
+	}
+	return nil
+}
+
+func (f *fakeNeon) createEndpoint(r *http.Request) (int, interface{}) {
+	project, err := f.project(r)
+	if err != nil {
+		return 0, err
+	}
+	var body endpointBody
+	if err := decode(r, &body); err != nil {
+		return 0, err
+	}
+	branch, ok := project.branches[body.Endpoint.BranchId]
+	if !ok {
+		return 0, notFound("branch %s not found", body.Endpoint.BranchId)
+	}
+	if body.Endpoint.RegionId != nil && *body.Endpoint.RegionId != project.RegionId {
+		return 0, &errorBody{http.StatusBadRequest, fmt.Sprintf("region %s does not match the project's region", *body.Endpoint.RegionId)}
+	}
+	if body.Endpoint.Type == "read_write" {
+		for _, endpoint := range project.branchEndpoints(branch.Id) {
+			if endpoint.Type == "read_write" {
+				return 0, &errorBody{http.StatusUnprocessableEntity, fmt.Sprintf("branch %s already has a read_write endpoint", branch.Id)}
+			}
+		}
+	}
+
+	endpoint := f.newEndpoint(project, branch.Id, body.Endpoint.Type)
+	if err := body.apply(endpoint); err != nil {
+		delete(project.endpoints, endpoint.Id)
+		return 0, err
+	}
+	return http.StatusCreated, map[string]interface{}{
+		"endpoint":   endpoint,
+		"operations": []*fakeOperation{f.operation(project, "start_compute", branch.Id, endpoint.Id)},
+	}
+}
+
+func (f *fakeNeon) getEndpoint(r *http.Request) (int, interface{}) {
+	project, err := f.project(r)
+	if err != nil {
+		return 0, err
+	}
+	endpoint, ok := project.endpoints[r.PathValue("endpoint")]
+	if !ok {
+		return 0, notFound("endpoint %s not found", r.PathValue("endpoint"))
+	}
+	return http.StatusOK, map[string]interface{}{"endpoint": endpoint}
+}
+
+func (f *fakeNeon) updateEndpoint(r *http.Request) (int, interface{}) {
+	project, err := f.project(r)
+	if err != nil {
+		return 0, err
+	}
+	endpoint, ok := project.endpoints[r.PathValue("endpoint")]
+	if !ok {
+		return 0, notFound("endpoint %s not found", r.PathValue("endpoint"))
+	}
+	var body endpointBody
+	if err := decode(r, &body); err != nil {
+		return 0, err
+	}
+	if branchId := body.Endpoint.BranchId; branchId != "" {
+		if _, ok := project.branches[branchId]; !ok {
+			return 0, notFound("branch %s not found", branchId)
+		}
+		endpoint.BranchId = branchId
+	}
+	if err := body.apply(endpoint); err != nil {
+		return 0, err
+	}
+	return http.StatusOK, map[string]interface{}{
+		"endpoint":   endpoint,
+		"operations": []*fakeOperation{f.operation(project, "apply_config", endpoint.BranchId, endpoint.Id)},
+	}
+}
+
+func (f *fakeNeon) deleteEndpoint(r *http.Request) (int, interface{}) {
+	project, err := f.project(r)
+	if err != nil {
+		return 0, err
+	}
+	endpoint, ok := project.endpoints[r.PathValue("endpoint")]
+	if !ok {
+		return 0, notFound("endpoint %s not found", r.PathValue("endpoint"))
+	}
+	delete(project.endpoints, endpoint.Id)
+	return http.StatusOK, map[string]interface{}{
+		"endpoint":   endpoint,
+		"operations": []*fakeOperation{f.operation(project, "suspend_compute", endpoint.BranchId, endpoint.Id)},
+	}
+}
+
+func (f *fakeNeon) listDatabases(r *http.Request) (int, interface{}) {
+	_, branch, err := f.branch(r)
+	if err != nil {
+		return 0, err
+	}
+	databases := []*fakeDatabase{}
+	for _, database := range branch.databases {
+		databases = append(databases, database)
+	}
+	sortById(databases, func(d *fakeDatabase) string { return d.Name })
+	return http.StatusOK, map[string]interface{}{"databases": databases}
+}
+
+type databaseBody struct {
+	Database struct {
+		Name      string `json:"name"`
+		OwnerName string `json:"owner_name"`
+	} `json:"database"`
+}
+
+// check rejects a name taken by another database and an owner that is not a role.
+func (b databaseBody) check(branch *fakeBranch, current *fakeDatabase) *errorBody {
+	if other, ok := branch.databases[b.Database.Name]; ok && other != current {
+		return &errorBody{http.StatusConflict, fmt.Sprintf("database %q already exists", b.Database.Name)}
+	}
+	if _, ok := branch.roles[b.Database.OwnerName]; !ok {
+		return &errorBody{http.StatusBadRequest, fmt.Sprintf("role %q does not exist", b.Database.OwnerName)}
+	}
+	return nil
+}
+
+func (f *fakeNeon) createDatabase(r *http.Request) (int, interface{}) {
+	project, branch, err := f.branch(r)
+	if err != nil {
+		return 0, err
+	}
+	var body databaseBody
+	if err := decode(r, &body); err != nil {
+		return 0, err
+	}
+	if err := body.check(branch, nil); err != nil {
+		return 0, err
+	}
+
+	f.nextId++
+	database := &fakeDatabase{
+		Id:        int64(f.nextId),
+		Name:      body.Database.Name,
+		OwnerName: body.Database.OwnerName,
+		BranchId:  branch.Id,
+		CreatedAt: now(),
+	}
+	branch.databases[database.Name] = database
+	return http.StatusCreated, map[string]interface{}{
+		"database":   database,
+		"operations": []*fakeOperation{f.operation(project, "apply_config", branch.Id, "")},
+	}
+}
+
+func (f *fakeNeon) database(r *http.Request) (*fakeProject, *fakeBranch, *fakeDatabase, *errorBody) {
+	project, branch, err := f.branch(r)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	database, ok := branch.databases[r.PathValue("database")]
+	if !ok {
+		return nil, nil, nil, notFound("database %q not found", r.PathValue("database"))
+	}
+	return project, branch, database, nil
+}
+
+func (f *fakeNeon) getDatabase(r *http.Request) (int, interface{}) {
+	_, _, database, err := f.database(r)
+	if err != nil {
+		return 0, err
+	}
+	return http.StatusOK, map[string]interface{}{"database": database}
+}
+
+func (f *fakeNeon) updateDatabase(r *http.Request) (int, interface{}) {
+	project, branch, database, err := f.database(r)
+	if err != nil {
+		return 0, err
+	}
+	var body databaseBody
+	if err := decode(r, &body); err != nil {
+		return 0, err
+	}
+	if err := body.check(branch, database); err != nil {
+		return 0, err
+	}
+
+	delete(branch.databases, database.Name)
+	database.Name, database.OwnerName = body.Database.Name, body.Database.OwnerName
+	branch.databases[database.Name] = database
+	return http.StatusOK, map[string]interface{}{
+		"database":   database,
+		"operations": []*fakeOperation{f.operation(project, "apply_config", branch.Id, "")},
+	}
+}
+
+func (f *fakeNeon) deleteDatabase(r *http.Request) (int, interface{}) {
+	project, branch, database, err := f.database(r)
+	if err != nil {
+		return 0, err
+	}
+	delete(branch.databases, database.Name)
+	return http.StatusOK, map[string]interface{}{
+		"database":   database,
+		"operations": []*fakeOperation{f.operation(project, "apply_config", branch.Id, "")},
+	}
+}
+
+// withoutPassword returns a copy of role as Neon lists it, without its password.
+func withoutPassword(role *fakeRole) *fakeRole {
+	copied := *role
+	copied.Password = ""
+	return &copied
+}
+
+func (f *fakeNeon) listRoles(r *http.Request) (int, interface{}) {
+	_, branch, err := f.branch(r)
+	if err != nil {
+		return 0, err
+	}
+	roles := []*fakeRole{}
+	for _, role := range branch.roles {
+		roles = append(roles, withoutPassword(role))
+	}
+	sortById(roles, func(r *fakeRole) string { return r.Name })
+	return http.StatusOK, map[string]interface{}{"roles": roles}
+}
+
+func (f *fakeNeon) createRole(r *http.Request) (int, interface{}) {
+	project, branch, err := f.branch(r)
+	if err != nil {
+		return 0, err
+	}
+	var body struct {
+		Role struct {
+			Name string `json:"name"`
+		} `json:"role"`
+	}
+	if err := decode(r, &body); err != nil {
+		return 0, err
+	}
+	if _, ok := branch.roles[body.Role.Name]; ok {
+		return 0, &errorBody{http.StatusConflict, fmt.Sprintf("role %q already exists", body.Role.Name)}
+	}
+
+	role := &fakeRole{Name: body.Role.Name, BranchId: branch.Id, Password: f.id("pw"), CreatedAt: now()}
+	branch.roles[role.Name] = role
+	return http.StatusCreated, map[string]interface{}{
+		"role":       role,
+		"operations": []*fakeOperation{f.operation(project, "apply_config", branch.Id, "")},
+	}
+}
+
+func (f *fakeNeon) role(r *http.Request) (*fakeProject, *fakeBranch, *fakeRole, *errorBody) {
+	project, branch, err := f.branch(r)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	role, ok := branch.roles[r.PathValue("role")]
+	if !ok {
+		return nil, nil, nil, notFound("role %q not found", r.PathValue("role"))
+	}
+	return project, branch, role, nil
+}
+
+func (f *fakeNeon) getRole(r *http.Request) (int, interface{}) {
+	_, _, role, err := f.role(r)
+	if err != nil {
+		return 0, err
+	}
+	return http.StatusOK, map[string]interface{}{"role": withoutPassword(role)}
+}
+
+func (f *fakeNeon) deleteRole(r *http.Request) (int, interface{}) {
+	project, branch, role, err := f.role(r)
+	if err != nil {
+		return 0, err
+	}
+	for _, database := range branch.databases {
+		if database.OwnerName == role.Name {
+			return 0, &errorBody{http.StatusUnprocessableEntity, fmt.Sprintf("role %q owns database %q", role.Name, database.Name)}
+		}
+	}
+	delete(branch.roles, role.Name)
+	return http.StatusOK, map[string]interface{}{
+		"role":       withoutPassword(role),
+		"operations": []*fakeOperation{f.operation(project, "apply_config", branch.Id, "")},
+	}
+}
+
+func (f *fakeNeon) revealRolePassword(r *http.Request) (int, interface{}) {
+	project, _, role, err := f.role(r)
+	if err != nil {
+		return 0, err
+	}
+	if !project.StorePasswords {
+		return 0, &errorBody{http.StatusBadRequest, "project does not store passwords"}
+	}
+	return http.StatusOK, map[string]interface{}{"password": role.Password}
+}
+
+func (f *fakeNeon) resetRolePassword(r *http.Request) (int, interface{}) {
+	project, branch, role, err := f.role(r)
+	if err != nil {
+		return 0, err
+	}
+	role.Password = f.id("pw")
+	return http.StatusOK, map[string]interface{}{
+		"role":       role,
+		"operations": []*fakeOperation{f.operation(project, "apply_config", branch.Id, "")},
+	}
+}
@@ -15,42 +15,779 @@
 package tests
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/blang/semver"
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/integration"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	rpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	neon "github.com/DonsWayo/pulumi-neon/provider"
 )
 
-func TestRandomCreate(t *testing.T) {
-	prov := provider()
+// newServer starts a fake Neon API and a provider server configured to send all of its
+// requests there, with any extra provider configuration in config.
+func newServer(t *testing.T, config map[string]interface{}) (integration.Server, *fakeNeon) {
+	api := newFakeNeon(t)
 
-	response, err := prov.Create(p.CreateRequest{
-		Urn: urn("Random"),
-		Properties: resource.PropertyMap{
-			"length": resource.NewNumberProperty(12),
+	args := props(config)
+	args["apiKey"] = resource.NewStringProperty(testAPIKey)
+	args["apiUrl"] = resource.NewStringProperty(api.URL())
+
+	server := integration.NewServer(neon.Name, semver.MustParse("1.0.0"), neon.Provider())
+	require.NoError(t, server.Configure(p.ConfigureRequest{Args: args}))
+	return server, api
+}
+
+func props(m map[string]interface{}) resource.PropertyMap {
+	return resource.NewPropertyMapFromMap(m)
+}
+
+// urn is a helper function to build an urn for running integration tests.
+func urn(typ string) resource.URN {
+	return resource.NewURN("stack", "proj", "", token(typ), "name")
+}
+
+func token(typ string) tokens.Type {
+	return tokens.Type("neon:index:" + typ)
+}
+
+// secret asserts that v is a secret and returns the string inside it.
+func secret(t *testing.T, v resource.PropertyValue) string {
+	require.True(t, v.IsSecret(), "expected a secret")
+	return v.SecretValue().Element.StringValue()
+}
+
+func TestProjectLifecycle(t *testing.T) {
+	server, api := newServer(t, nil)
+
+	integration.LifeCycleTest{
+		Resource: token("Project"),
+		Create: integration.Operation{
+			Inputs: props(map[string]interface{}{"name": "app", "regionId": "aws-us-east-2"}),
+			Hook: func(inputs, output resource.PropertyMap) {
+				project := api.projects[output["projectId"].StringValue()]
+				require.NotNil(t, project)
+				assert.Equal(t, project.defaultBranch().Id, output["defaultBranchId"].StringValue())
+				assert.Equal(t, "neondb_owner", output["defaultRoleName"].StringValue())
+				assert.Equal(t, "neondb", output["defaultDatabaseName"].StringValue())
+				host := output["defaultEndpointHost"].StringValue()
+				assert.Contains(t, secret(t, output["connectionUri"]), "@"+host+"/neondb")
+			},
 		},
-		Preview: false,
+		Updates: []integration.Operation{
+			{
+				Inputs: props(map[string]interface{}{"name": "renamed", "regionId": "aws-us-east-2", "historyRetentionSeconds": 3600}),
+				Hook: func(inputs, output resource.PropertyMap) {
+					project := api.projects[output["projectId"].StringValue()]
+					assert.Equal(t, "renamed", project.Name)
+					assert.Equal(t, 3600, project.HistoryRetentionSeconds)
+				},
+			},
+			{
+				// A new Postgres version replaces the project.
+				Inputs: props(map[string]interface{}{"name": "renamed", "regionId": "aws-us-east-2", "pgVersion": 17}),
+				Hook: func(inputs, output resource.PropertyMap) {
+					assert.Equal(t, float64(17), output["pgVersion"].NumberValue())
+				},
+			},
+		},
+	}.Run(t, server)
+
+	assert.Empty(t, api.projects)
+}
+
+func TestRefreshProjectWithoutStoredPasswords(t *testing.T) {
+	server, api := newServer(t, nil)
+
+	created, err := server.Create(p.CreateRequest{
+		Urn:        urn("Project"),
+		Properties: props(map[string]interface{}{"name": "app", "regionId": "aws-us-east-2", "storePasswords": false}),
 	})
+	require.NoError(t, err)
+	uri := secret(t, created.Properties["connectionUri"])
+	require.NotEmpty(t, uri)
+
+	// Neon cannot build a connection URI for the project, so the one from creation is
+	// kept on refresh.
+	read, err := server.Read(p.ReadRequest{ID: created.ID, Urn: urn("Project"), Properties: created.Properties})
+	require.NoError(t, err)
+	assert.Equal(t, uri, secret(t, read.Properties["connectionUri"]))
 
+	// A project whose default endpoint has been removed has no URI to look up.
+	project := api.projects[created.ID]
+	for id := range project.endpoints {
+		delete(project.endpoints, id)
+	}
+	read, err = server.Read(p.ReadRequest{ID: created.ID, Urn: urn("Project")})
 	require.NoError(t, err)
-	result := response.Properties["result"].StringValue()
-	assert.Len(t, result, 12)
+	assert.Empty(t, read.Properties["defaultEndpointHost"].StringValue())
 }
 
-// urn is a helper function to build an urn for running integration tests.
-func urn(typ string) resource.URN {
-	return resource.NewURN("stack", "proj", "",
-		tokens.Type("test:index:"+typ), "name")
+func TestBranchLifecycle(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+	mainBranch := project.defaultBranch()
+
+	integration.LifeCycleTest{
+		Resource: token("Branch"),
+		Create: integration.Operation{
+			Inputs: props(map[string]interface{}{
+				"projectId": project.Id,
+				"name":      "dev",
+				"endpoints": []interface{}{
+					map[string]interface{}{"type": "read_write", "autoscalingLimitMaxCu": 1},
+				},
+			}),
+			Hook: func(inputs, output resource.PropertyMap) {
+				assert.Equal(t, mainBranch.Id, output["parentId"].StringValue())
+				branch := project.branches[output["branchId"].StringValue()]
+				require.NotNil(t, branch)
+				assert.Equal(t, "dev", branch.Name)
+
+				created := output["createdEndpoints"].ArrayValue()
+				require.Len(t, created, 1)
+				endpoint := project.endpoints[created[0].ObjectValue()["endpointId"].StringValue()]
+				require.NotNil(t, endpoint)
+				assert.Equal(t, branch.Id, endpoint.BranchId)
+				assert.Equal(t, float64(1), endpoint.AutoscalingLimitMaxCu)
+			},
+		},
+		Updates: []integration.Operation{
+			{
+				Inputs: props(map[string]interface{}{
+					"projectId": project.Id,
+					"name":      "feature",
+					"endpoints": []interface{}{
+						map[string]interface{}{"type": "read_write", "autoscalingLimitMaxCu": 1},
+					},
+				}),
+				Hook: func(inputs, output resource.PropertyMap) {
+					assert.Equal(t, "feature", project.branches[output["branchId"].StringValue()].Name)
+				},
+			},
+			{
+				// Changing the inline endpoints replaces the branch, deleting the old one
+				// first since the name is kept.
+				Inputs: props(map[string]interface{}{
+					"projectId": project.Id,
+					"name":      "feature",
+					"endpoints": []interface{}{
+						map[string]interface{}{"type": "read_write", "autoscalingLimitMaxCu": 2},
+					},
+				}),
+				Hook: func(inputs, output resource.PropertyMap) {
+					assert.Len(t, project.branches, 2)
+					assert.Len(t, project.endpoints, 2)
+				},
+			},
+		},
+	}.Run(t, server)
+
+	assert.Len(t, project.branches, 1)
+	assert.Len(t, project.endpoints, 1, "only the default branch's endpoint should be left")
+}
+
+func TestBranchExpiry(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+
+	inputs := props(map[string]interface{}{"projectId": project.Id, "name": "ci-1234", "ttl": "24h"})
+	check, err := server.Check(p.CheckRequest{Urn: urn("Branch"), News: inputs})
+	require.NoError(t, err)
+	require.Empty(t, check.Failures)
+	created, err := server.Create(p.CreateRequest{Urn: urn("Branch"), Properties: check.Inputs})
+	require.NoError(t, err)
+
+	branch := project.branches[created.Properties["branchId"].StringValue()]
+	require.NotNil(t, branch)
+	require.NotNil(t, branch.ExpiresAt)
+	expiresAt, err := time.Parse(time.RFC3339, *branch.ExpiresAt)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), expiresAt, time.Minute)
+	assert.Equal(t, *branch.ExpiresAt, created.Properties["expiresAt"].StringValue())
+
+	// The expiration passes before Neon gets around to deleting the branch.
+	past := time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	branch.ExpiresAt = &past
+	state := created.Properties.Copy()
+	state["expiresAt"] = resource.NewStringProperty(past)
+	read, err := server.Read(p.ReadRequest{ID: created.ID, Urn: urn("Branch"), Properties: state})
+	require.NoError(t, err)
+	assert.Empty(t, read.ID)
+
+	// Once Neon has deleted it, deleting it through Pulumi still succeeds.
+	api.deleteExpiredBranches()
+	assert.NotContains(t, project.branches, branch.Id)
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: created.ID, Urn: urn("Branch"), Properties: state}))
+}
+
+func TestEndpointLifecycle(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+	branch := api.seedBranch(project, "dev")
+
+	inputs := func(extra map[string]interface{}) resource.PropertyMap {
+		m := map[string]interface{}{
+			"projectId":    project.Id,
+			"branchId":     branch.Id,
+			"type":         "read_write",
+			"roleName":     "neondb_owner",
+			"databaseName": "neondb",
+		}
+		for k, v := range extra {
+			m[k] = v
+		}
+		return props(m)
+	}
+
+	integration.LifeCycleTest{
+		Resource: token("Endpoint"),
+		Create: integration.Operation{
+			Inputs: inputs(map[string]interface{}{"autoscalingLimitMaxCu": 1}),
+			Hook: func(inputs, output resource.PropertyMap) {
+				endpointId := output["endpointId"].StringValue()
+				host := output["host"].StringValue()
+				assert.True(t, strings.HasPrefix(host, endpointId+"."), host)
+				assert.Equal(t, endpointId+"-pooler"+strings.TrimPrefix(host, endpointId), output["poolerHost"].StringValue())
+				assert.Contains(t, secret(t, output["connectionUri"]), "@"+host+"/neondb")
+				assert.Contains(t, secret(t, output["pooledConnectionUri"]), "@"+output["poolerHost"].StringValue()+"/neondb")
+			},
+		},
+		Updates: []integration.Operation{
+			{
+				Inputs: inputs(map[string]interface{}{
+					"autoscalingLimitMaxCu": 2,
+					"suspendTimeoutSeconds": 300,
+					"poolerEnabled":         true,
+					"settings":              map[string]interface{}{"work_mem": "64MB"},
+				}),
+				Hook: func(inputs, output resource.PropertyMap) {
+					endpoint := project.endpoints[output["endpointId"].StringValue()]
+					assert.Equal(t, float64(2), endpoint.AutoscalingLimitMaxCu)
+					assert.Equal(t, 300, endpoint.SuspendTimeoutSeconds)
+					assert.True(t, endpoint.PoolerEnabled)
+					assert.Equal(t, map[string]string{"work_mem": "64MB"}, endpoint.Settings.PgSettings)
+				},
+			},
+		},
+	}.Run(t, server)
+
+	assert.Empty(t, project.branchEndpoints(branch.Id))
+}
+
+func TestRefreshEndpointKeepsConnectionURIs(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+
+	created, err := server.Create(p.CreateRequest{
+		Urn: urn("Endpoint"),
+		Properties: props(map[string]interface{}{
+			"projectId":    project.Id,
+			"branchId":     project.defaultBranch().Id,
+			"type":         "read_only",
+			"roleName":     "neondb_owner",
+			"databaseName": "neondb",
+		}),
+	})
+	require.NoError(t, err)
+	uri := secret(t, created.Properties["connectionUri"])
+
+	// Once the URIs can no longer be looked up, a refresh keeps the known ones.
+	project.StorePasswords = false
+	read, err := server.Read(p.ReadRequest{ID: created.ID, Urn: urn("Endpoint"), Properties: created.Properties})
+	require.NoError(t, err)
+	assert.Equal(t, uri, secret(t, read.Properties["connectionUri"]))
+	assert.Equal(t, secret(t, created.Properties["pooledConnectionUri"]), secret(t, read.Properties["pooledConnectionUri"]))
+}
+
+func TestDatabaseLifecycle(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+	branch := project.defaultBranch()
+	api.seedRole(branch, "app")
+
+	inputs := func(name, owner string) resource.PropertyMap {
+		return props(map[string]interface{}{
+			"projectId": project.Id,
+			"branchId":  branch.Id,
+			"name":      name,
+			"ownerName": owner,
+		})
+	}
+
+	integration.LifeCycleTest{
+		Resource: token("Database"),
+		Create: integration.Operation{
+			Inputs: inputs("appdb", "neondb_owner"),
+			Hook: func(inputs, output resource.PropertyMap) {
+				require.Contains(t, branch.databases, "appdb")
+				assert.NotEmpty(t, output["databaseId"].StringValue())
+			},
+		},
+		Updates: []integration.Operation{
+			{
+				Inputs: inputs("reports", "neondb_owner"),
+				Hook: func(inputs, output resource.PropertyMap) {
+					assert.NotContains(t, branch.databases, "appdb")
+					assert.Contains(t, branch.databases, "reports")
+				},
+			},
+			{
+				Inputs: inputs("reports", "app"),
+				Hook: func(inputs, output resource.PropertyMap) {
+					assert.Equal(t, "app", branch.databases["reports"].OwnerName)
+				},
+			},
+			{
+				Inputs:        inputs("reports", "missing"),
+				ExpectFailure: true,
+			},
+		},
+	}.Run(t, server)
+
+	assert.Equal(t, []string{"neondb"}, databaseNames(branch))
+}
+
+func databaseNames(branch *fakeBranch) []string {
+	var names []string
+	for name := range branch.databases {
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestRoleLifecycle(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+	branch := project.defaultBranch()
+
+	var password string
+	integration.LifeCycleTest{
+		Resource: token("Role"),
+		Create: integration.Operation{
+			Inputs: props(map[string]interface{}{"projectId": project.Id, "branchId": branch.Id, "name": "app"}),
+			Hook: func(inputs, output resource.PropertyMap) {
+				require.Contains(t, branch.roles, "app")
+				password = secret(t, output["password"])
+				assert.Equal(t, branch.roles["app"].Password, password)
+			},
+		},
+		Updates: []integration.Operation{
+			{
+				Inputs: props(map[string]interface{}{"projectId": project.Id, "branchId": branch.Id, "name": "app", "passwordVersion": "2"}),
+				Hook: func(inputs, output resource.PropertyMap) {
+					rotated := secret(t, output["password"])
+					assert.NotEqual(t, password, rotated)
+					assert.Equal(t, branch.roles["app"].Password, rotated)
+				},
+			},
+			{
+				// Roles cannot be renamed, so a new name replaces the role. The new role is
+				// created before the old one is deleted.
+				Inputs: props(map[string]interface{}{"projectId": project.Id, "branchId": branch.Id, "name": "service", "passwordVersion": "2"}),
+				Hook: func(inputs, output resource.PropertyMap) {
+					assert.Contains(t, branch.roles, "app")
+					assert.Equal(t, branch.roles["service"].Password, secret(t, output["password"]))
+				},
+			},
+		},
+	}.Run(t, server)
+
+	assert.NotContains(t, branch.roles, "app")
+	assert.NotContains(t, branch.roles, "service")
+}
+
+func TestImportRoleWithoutStoredPassword(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+	project.StorePasswords = false
+	branch := project.defaultBranch()
+	api.seedRole(branch, "app")
+
+	id := project.Id + "/" + branch.Id + "/app"
+	read, err := server.Read(p.ReadRequest{ID: id, Urn: urn("Role")})
+
+	require.NoError(t, err)
+	assert.Equal(t, id, read.ID)
+	assert.Equal(t, "app", read.Properties["name"].StringValue())
+	assert.Empty(t, secret(t, read.Properties["password"]))
+}
+
+func TestGetProject(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("shared")
+	api.seedProject("other")
+
+	byId, err := server.Invoke(p.InvokeRequest{
+		Token: token("getProject"),
+		Args:  props(map[string]interface{}{"projectId": project.Id}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "shared", byId.Return["name"].StringValue())
+	assert.Equal(t, "aws-us-east-2", byId.Return["regionId"].StringValue())
+
+	byName, err := server.Invoke(p.InvokeRequest{
+		Token: token("getProject"),
+		Args:  props(map[string]interface{}{"name": "shared"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, project.Id, byName.Return["projectId"].StringValue())
+
+	api.seedProject("shared")
+	_, err = server.Invoke(p.InvokeRequest{
+		Token: token("getProject"),
+		Args:  props(map[string]interface{}{"name": "shared"}),
+	})
+	assert.ErrorContains(t, err, "more than one project")
+
+	_, err = server.Invoke(p.InvokeRequest{
+		Token: token("getProject"),
+		Args:  props(map[string]interface{}{"name": "missing"}),
+	})
+	assert.ErrorContains(t, err, `no project is named "missing"`)
+}
+
+func TestGetBranch(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+	branch := api.seedBranch(project, "staging")
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: token("getBranch"),
+		Args:  props(map[string]interface{}{"projectId": project.Id, "name": "staging"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, branch.Id, resp.Return["branchId"].StringValue())
+	assert.Equal(t, project.defaultBranch().Id, resp.Return["parentId"].StringValue())
+
+	_, err = server.Invoke(p.InvokeRequest{
+		Token: token("getBranch"),
+		Args:  props(map[string]interface{}{"projectId": project.Id, "name": "missing"}),
+	})
+	assert.ErrorContains(t, err, `branch "missing" not found`)
+}
+
+func TestGetEndpoints(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+	mainBranch := project.defaultBranch()
+
+	resp, err := server.Invoke(p.InvokeRequest{
+		Token: token("getEndpoints"),
+		Args:  props(map[string]interface{}{"projectId": project.Id, "branchId": mainBranch.Id}),
+	})
+	require.NoError(t, err)
+	endpoints := resp.Return["endpoints"].ArrayValue()
+	require.Len(t, endpoints, 1)
+	endpoint := endpoints[0].ObjectValue()
+	assert.Equal(t, "read_write", endpoint["type"].StringValue())
+	assert.Equal(t, project.branchEndpoints(mainBranch.Id)[0].Host, endpoint["host"].StringValue())
+	assert.Equal(t, float64(5432), endpoint["port"].NumberValue())
+
+	branch := api.seedBranch(project, "dev")
+	resp, err = server.Invoke(p.InvokeRequest{
+		Token: token("getEndpoints"),
+		Args:  props(map[string]interface{}{"projectId": project.Id, "branchId": branch.Id}),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Return["endpoints"].ArrayValue())
+}
+
+func TestGetRegions(t *testing.T) {
+	server, _ := newServer(t, nil)
+
+	resp, err := server.Invoke(p.InvokeRequest{Token: token("getRegions"), Args: props(nil)})
+	require.NoError(t, err)
+	regions := resp.Return["regions"].ArrayValue()
+	require.Len(t, regions, 2)
+	assert.Equal(t, "aws-eu-central-1", regions[0].ObjectValue()["regionId"].StringValue())
+	assert.True(t, regions[1].ObjectValue()["default"].BoolValue())
+}
+
+// providerMocks runs a Pulumi program's custom resources through the provider, so that
+// components can be tested against the fake Neon API.
+type providerMocks struct {
+	server integration.Server
+}
+
+func (m providerMocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	if !args.Custom {
+		return args.Name, args.Inputs, nil
+	}
+	urn := resource.NewURN("stack", "proj", "", tokens.Type(args.TypeToken), args.Name)
+	check, err := m.server.Check(p.CheckRequest{Urn: urn, News: args.Inputs})
+	if err != nil {
+		return "", nil, err
+	}
+	if len(check.Failures) > 0 {
+		return "", nil, fmt.Errorf("%s: %s", check.Failures[0].Property, check.Failures[0].Reason)
+	}
+	created, err := m.server.Create(p.CreateRequest{Urn: urn, Properties: check.Inputs})
+	if err != nil {
+		return "", nil, err
+	}
+	return created.ID, created.Properties, nil
+}
+
+func (m providerMocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	return nil, fmt.Errorf("unexpected call to %s", args.Token)
+}
+
+// constructPreviewDatabase runs a program that constructs a PreviewDatabase and returns
+// its resolved outputs.
+func constructPreviewDatabase(t *testing.T, server integration.Server, args neon.PreviewDatabaseArgs) map[string]interface{} {
+	outputs := map[string]interface{}{}
+	err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+		preview, err := neon.PreviewDatabase{}.Construct(ctx, "preview", string(token("PreviewDatabase")), args, pulumi.Composite())
+		if err != nil {
+			return err
+		}
+		pulumi.All(preview.BranchId, preview.RoleName, preview.DatabaseName, preview.Dsn, preview.ExpiresAt, preview.CreatedAt).
+			ApplyT(func(v []interface{}) error {
+				for i, key := range []string{"branchId", "roleName", "databaseName", "dsn", "expiresAt", "createdAt"} {
+					outputs[key] = v[i]
+				}
+				return nil
+			})
+		return nil
+	}, pulumi.WithMocks("proj", "stack", providerMocks{server}))
+	require.NoError(t, err)
+	return outputs
+}
+
+func TestPreviewDatabase(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+
+	outputs := constructPreviewDatabase(t, server, neon.PreviewDatabaseArgs{
+		ProjectId: pulumi.String(project.Id),
+		Name:      pulumi.String("preview/pr-42"),
+		Ttl:       pulumi.StringPtr("72h"),
+	})
+
+	branch := project.branches[outputs["branchId"].(string)]
+	require.NotNil(t, branch)
+	assert.Equal(t, "preview/pr-42", branch.Name)
+	assert.Equal(t, project.defaultBranch().Id, *branch.ParentId)
+	assert.Contains(t, branch.roles, "preview_owner")
+	require.Contains(t, branch.databases, "preview")
+	assert.Equal(t, "preview_owner", branch.databases["preview"].OwnerName)
+
+	endpoints := project.branchEndpoints(branch.Id)
+	require.Len(t, endpoints, 1)
+	assert.Equal(t, "read_write", endpoints[0].Type)
+	assert.Contains(t, outputs["dsn"], "preview_owner:"+branch.roles["preview_owner"].Password+"@"+endpoints[0].Host+"/preview")
+
+	created, err := time.Parse(time.RFC3339, outputs["createdAt"].(string))
+	require.NoError(t, err)
+	expiresAt, err := time.Parse(time.RFC3339, *outputs["expiresAt"].(*string))
+	require.NoError(t, err)
+	assert.WithinDuration(t, created.Add(72*time.Hour), expiresAt, time.Minute)
+	assert.Equal(t, *branch.ExpiresAt, *outputs["expiresAt"].(*string))
 }
 
-// Create a test server.
-func provider() integration.Server {
-	return integration.NewServer(neon.Name, semver.MustParse("1.0.0"), neon.Provider())
+func TestPreviewDatabaseFromParentBranch(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+	staging := api.seedBranch(project, "staging")
+
+	outputs := constructPreviewDatabase(t, server, neon.PreviewDatabaseArgs{
+		ProjectId:      pulumi.String(project.Id),
+		ParentBranchId: pulumi.StringPtr(staging.Id),
+		Name:           pulumi.String("preview/pr-43"),
+		RoleName:       pulumi.StringPtr("web"),
+		DatabaseName:   pulumi.StringPtr("shop"),
+	})
+
+	branch := project.branches[outputs["branchId"].(string)]
+	require.NotNil(t, branch)
+	assert.Equal(t, staging.Id, *branch.ParentId)
+	assert.Contains(t, branch.roles, "web")
+	assert.Contains(t, branch.databases, "shop")
+	assert.Equal(t, "web", outputs["roleName"])
+	assert.Nil(t, outputs["expiresAt"])
+}
+
+func TestReadReflectsChangesOutsidePulumi(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+	branch := api.seedBranch(project, "dev")
+
+	state := props(map[string]interface{}{"projectId": project.Id, "branchId": branch.Id, "name": "dev"})
+	api.mu.Lock()
+	branch.Name = "renamed"
+	api.mu.Unlock()
+
+	resp, err := server.Read(p.ReadRequest{ID: project.Id + "/" + branch.Id, Urn: urn("Branch"), Properties: state})
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", resp.Inputs["name"].StringValue())
+
+	api.mu.Lock()
+	delete(project.branches, branch.Id)
+	api.mu.Unlock()
+
+	resp, err = server.Read(p.ReadRequest{ID: project.Id + "/" + branch.Id, Urn: urn("Branch"), Properties: state})
+	require.NoError(t, err)
+	assert.Empty(t, resp.ID)
+}
+
+func TestOperationsFinishBeforeReturning(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+	api.operationPolls = 1
+
+	_, err := server.Create(p.CreateRequest{
+		Urn:        urn("Role"),
+		Properties: props(map[string]interface{}{"projectId": project.Id, "branchId": project.defaultBranch().Id, "name": "app"}),
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, api.unfinishedOperations())
+}
+
+func TestRetriesInjectedFaults(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+	branchesPath := "/projects/" + project.Id + "/branches"
+
+	api.inject(
+		fault{Method: http.MethodPost, Path: branchesPath, Status: http.StatusLocked},
+		fault{Method: http.MethodPost, Path: branchesPath, Status: http.StatusTooManyRequests, RetryAfter: "0"},
+	)
+	resp, err := server.Create(p.CreateRequest{
+		Urn:        urn("Branch"),
+		Properties: props(map[string]interface{}{"projectId": project.Id, "name": "dev"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, api.count(http.MethodPost, branchesPath))
+
+	branchPath := branchesPath + "/" + resp.Properties["branchId"].StringValue()
+	api.inject(
+		fault{Method: http.MethodGet, Path: branchPath, Status: http.StatusServiceUnavailable},
+		fault{Method: http.MethodGet, Path: branchPath, Status: http.StatusBadGateway},
+	)
+	read, err := server.Read(p.ReadRequest{ID: resp.ID, Urn: urn("Branch"), Properties: resp.Properties})
+	require.NoError(t, err)
+	assert.Equal(t, resp.ID, read.ID)
+	assert.Equal(t, 3, api.count(http.MethodGet, branchPath))
+}
+
+func TestServerErrorsAreNotRetriedForCreates(t *testing.T) {
+	server, api := newServer(t, nil)
+	project := api.seedProject("app")
+	branchesPath := "/projects/" + project.Id + "/branches"
+
+	api.inject(fault{Method: http.MethodPost, Path: branchesPath, Status: http.StatusInternalServerError})
+	_, err := server.Create(p.CreateRequest{
+		Urn:        urn("Branch"),
+		Properties: props(map[string]interface{}{"projectId": project.Id, "name": "dev"}),
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, 1, api.count(http.MethodPost, branchesPath))
+	assert.Len(t, project.branches, 1)
+}
+
+func TestInjectedLatencyTimesOut(t *testing.T) {
+	server, api := newServer(t, map[string]interface{}{"requestTimeoutSeconds": 1, "maxRetries": 0})
+	project := api.seedProject("app")
+	branch := project.defaultBranch()
+
+	api.inject(fault{Method: http.MethodGet, Path: "/projects/" + project.Id + "/branches/" + branch.Id, Delay: 3 * time.Second})
+	_, err := server.Read(p.ReadRequest{
+		ID:         project.Id + "/" + branch.Id,
+		Urn:        urn("Branch"),
+		Properties: props(map[string]interface{}{"projectId": project.Id, "branchId": branch.Id, "name": "main"}),
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error sending request")
+}
+
+// callBranchMethod calls a Branch method on branchId through the provider's gRPC server,
+// the way the engine does.
+func callBranchMethod(t *testing.T, api *fakeNeon, method, projectId, branchId string, args map[string]interface{}, dryRun bool) *rpc.CallResponse {
+	server, err := neon.Server(nil)
+	require.NoError(t, err)
+	config, err := plugin.MarshalProperties(props(map[string]interface{}{
+		"apiKey": testAPIKey,
+		"apiUrl": api.URL(),
+	}), plugin.MarshalOptions{})
+	require.NoError(t, err)
+	_, err = server.Configure(context.Background(), &rpc.ConfigureRequest{Args: config, AcceptResources: true})
+	require.NoError(t, err)
+
+	callArgs := props(args)
+	callArgs["__self__"] = resource.MakeCustomResourceReference(urn("Branch"), resource.ID(projectId+"/"+branchId), "")
+	marshalled, err := plugin.MarshalProperties(callArgs, plugin.MarshalOptions{KeepResources: true})
+	require.NoError(t, err)
+	resp, err := server.Call(context.Background(), &rpc.CallRequest{
+		Tok:    "neon:index:Branch/" + method,
+		Args:   marshalled,
+		DryRun: dryRun,
+	})
+	require.NoError(t, err)
+	return resp
+}
+
+func TestBranchResetToParent(t *testing.T) {
+	api := newFakeNeon(t)
+	project := api.seedProject("app")
+	branch := api.seedBranch(project, "dev")
+	branch.databases["scratch"] = &fakeDatabase{Name: "scratch", OwnerName: "neondb_owner", BranchId: branch.Id}
+	api.operationPolls = 1
+
+	// Previews leave the branch alone and report the results as unknown.
+	resp := callBranchMethod(t, api, "resetToParent", project.Id, branch.Id, nil, true)
+	preview, err := plugin.UnmarshalProperties(resp.GetReturn(), plugin.MarshalOptions{KeepUnknowns: true})
+	require.NoError(t, err)
+	for _, key := range []resource.PropertyKey{"branchId", "name", "parentId", "parentLsn", "parentTimestamp"} {
+		assert.True(t, preview[key].IsComputed(), "%s should be unknown", key)
+	}
+	assert.Contains(t, branch.databases, "scratch")
+
+	resp = callBranchMethod(t, api, "resetToParent", project.Id, branch.Id, nil, false)
+	require.Empty(t, resp.GetFailures())
+	assert.Equal(t, branch.Id, resp.GetReturn().GetFields()["branchId"].GetStringValue())
+	assert.NotContains(t, branch.databases, "scratch")
+	assert.Empty(t, api.unfinishedOperations())
+}
+
+func TestBranchRestore(t *testing.T) {
+	api := newFakeNeon(t)
+	project := api.seedProject("app")
+	source := api.seedBranch(project, "staging")
+	source.databases["reports"] = &fakeDatabase{Name: "reports", OwnerName: "neondb_owner", BranchId: source.Id}
+	branch := api.seedBranch(project, "dev")
+	branch.databases["scratch"] = &fakeDatabase{Name: "scratch", OwnerName: "neondb_owner", BranchId: branch.Id}
+
+	resp := callBranchMethod(t, api, "restore", project.Id, branch.Id, map[string]interface{}{
+		"sourceBranchId":    source.Id,
+		"preserveUnderName": "dev_old",
+	}, false)
+	require.Empty(t, resp.GetFailures())
+	assert.Contains(t, branch.databases, "reports")
+	assert.NotContains(t, branch.databases, "scratch")
+
+	var preserved *fakeBranch
+	for _, other := range project.branches {
+		if other.Name == "dev_old" {
+			preserved = other
+		}
+	}
+	require.NotNil(t, preserved, "the branch's previous data should be preserved")
+	assert.Contains(t, preserved.databases, "scratch")
+	assert.Equal(t, branch.ParentId, preserved.ParentId)
 }